@@ -0,0 +1,119 @@
+package hbdm
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// maxBatchOrders is maximum orders number in one contract_batchorder request
+const maxBatchOrders = 10
+
+// BatchOrderResult is result of single order placement from BatchOrders
+type BatchOrderResult struct {
+	Index         int   // index of order in BatchOrders slice
+	OrderId       int64 // placed order ID, zero if order failed
+	ClientOrderId int64 // client order ID sent with order
+	Err           error // *APIError if order failed
+}
+
+// batchOrderResponse is contract_batchorder method response
+type batchOrderResponse struct {
+	Response
+	Data struct {
+		Errors []struct {
+			Index   int    `json:"index"`
+			ErrCode int    `json:"err_code"`
+			ErrMsg  string `json:"err_msg"`
+		} `json:"errors"`
+		Success []struct {
+			Index         int   `json:"index"`
+			OrderId       int64 `json:"order_id"`
+			ClientOrderId int64 `json:"client_order_id"`
+		} `json:"success"`
+	} `json:"data"`
+}
+
+// BatchOrders validates and place multiple orders, orders are sent by chunks of 10 per request.
+// Results are in the same order as given orders. When chunk request fails, Err of its orders
+// results is set to request error and the error is returned, these orders may be placed anyway,
+// so check them by ClientOrderId. Results of next chunks that are not sent have nil Err and zero OrderId
+func (h *Hbdm) BatchOrders(orders []OrderRequest) (results []BatchOrderResult, err error) {
+	return h.BatchOrdersCtx(context.Background(), orders)
+}
+
+// BatchOrdersCtx is BatchOrders with context for request cancellation and deadlines
+func (h *Hbdm) BatchOrdersCtx(ctx context.Context, orders []OrderRequest) (results []BatchOrderResult, err error) {
+	for i, order := range orders {
+		if err = order.Validate(); err != nil {
+			return nil, fmt.Errorf("order %d: %w", i, err)
+		}
+	}
+
+	results = make([]BatchOrderResult, len(orders))
+
+	for start := 0; start < len(orders); start += maxBatchOrders {
+		end := start + maxBatchOrders
+		if end > len(orders) {
+			end = len(orders)
+		}
+
+		if err = h.batchOrders(ctx, orders[start:end], results[start:end], start); err != nil {
+			for i := start; i < end; i++ {
+				results[i].Index = i
+				results[i].Err = err
+			}
+			return
+		}
+	}
+
+	return
+}
+
+// batchOrders place one chunk of orders and fills given results
+func (h *Hbdm) batchOrders(ctx context.Context, orders []OrderRequest, results []BatchOrderResult, offset int) (err error) {
+	ordersData := make([]Params, len(orders))
+
+	for i, order := range orders {
+		if order.ClientOrderId == 0 {
+			if order.ClientOrderId, err = h.GetAndIncrementNonce(); err != nil {
+				return
+			}
+		}
+
+		ordersData[i] = order.payload()
+		results[i] = BatchOrderResult{Index: offset + i, ClientOrderId: order.ClientOrderId}
+	}
+
+	payload := make(Params, 1)
+	payload.Set("orders_data", ordersData)
+
+	var resp batchOrderResponse
+	if err = h.client.call(ctx, "POST", "contract_batchorder", payload, true, false, &resp); err != nil {
+		return
+	}
+
+	// response indexes are 1-based positions in orders_data
+	for _, s := range resp.Data.Success {
+		if s.Index < 1 || s.Index > len(results) {
+			continue
+		}
+		results[s.Index-1].OrderId = s.OrderId
+	}
+
+	for _, e := range resp.Data.Errors {
+		if e.Index < 1 || e.Index > len(results) {
+			continue
+		}
+		results[e.Index-1].Err = &APIError{
+			Status:     resp.Status,
+			Code:       e.ErrCode,
+			Message:    e.ErrMsg,
+			Ts:         int64(resp.Ts),
+			Endpoint:   "contract_batchorder",
+			HTTPStatus: http.StatusOK,
+		}
+	}
+
+	return
+}
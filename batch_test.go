@@ -0,0 +1,114 @@
+package hbdm_test
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/andskur/hbdm-go"
+	"github.com/andskur/hbdm-go/hbdmtest"
+)
+
+func TestBatchOrders(t *testing.T) {
+	srv := hbdmtest.NewServer("key", "secret")
+	defer srv.Close()
+
+	// every chunk gets the same response: first order placed, second failed
+	err := srv.HandleJSON("/api/v1/contract_batchorder", map[string]interface{}{
+		"success": []map[string]interface{}{{"index": 1, "order_id": 101, "client_order_id": 1}},
+		"errors":  []map[string]interface{}{{"index": 2, "err_code": 1047, "err_msg": "Insufficient margin available"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	h := hbdm.New("key", "secret",
+		hbdm.WithEndpoints(srv.Endpoints()),
+		hbdm.WithNonceStore(hbdm.NewMemoryNonceStore(0)),
+	)
+
+	orders := make([]hbdm.OrderRequest, 12)
+	for i := range orders {
+		orders[i] = testOrder()
+	}
+
+	results, err := h.BatchOrders(orders)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != len(orders) {
+		t.Fatalf("got %d results, want %d", len(results), len(orders))
+	}
+
+	for i, r := range results {
+		if r.Index != i || r.ClientOrderId != int64(i+1) {
+			t.Errorf("result %d: index %d, client order id %d", i, r.Index, r.ClientOrderId)
+		}
+
+		switch i {
+		case 0, 10:
+			if r.OrderId != 101 || r.Err != nil {
+				t.Errorf("result %d: got order id %d and error %v, want placed order", i, r.OrderId, r.Err)
+			}
+		case 1, 11:
+			if r.OrderId != 0 || !errors.Is(r.Err, hbdm.ErrInsufficientMargin) {
+				t.Errorf("result %d: got order id %d and error %v, want %v", i, r.OrderId, r.Err, hbdm.ErrInsufficientMargin)
+			}
+		default:
+			if r.OrderId != 0 || r.Err != nil {
+				t.Errorf("result %d: got order id %d and error %v, want empty result", i, r.OrderId, r.Err)
+			}
+		}
+	}
+
+	requests := srv.Requests()
+	if len(requests) != 2 {
+		t.Fatalf("got %d requests, want 2", len(requests))
+	}
+	for i, want := range []int{10, 2} {
+		var payload struct {
+			OrdersData []map[string]interface{} `json:"orders_data"`
+		}
+		if err := requests[i].Params(&payload); err != nil {
+			t.Fatal(err)
+		}
+		if len(payload.OrdersData) != want {
+			t.Errorf("request %d: got %d orders, want %d", i, len(payload.OrdersData), want)
+		}
+	}
+}
+
+func TestBatchOrdersRequestError(t *testing.T) {
+	srv := hbdmtest.NewServer("key", "secret")
+	defer srv.Close()
+	srv.Handle("/api/v1/contract_batchorder", http.StatusServiceUnavailable, "")
+
+	h := hbdm.New("key", "secret",
+		hbdm.WithEndpoints(srv.Endpoints()),
+		hbdm.WithNonceStore(hbdm.NewMemoryNonceStore(0)),
+	)
+
+	orders := make([]hbdm.OrderRequest, 12)
+	for i := range orders {
+		orders[i] = testOrder()
+	}
+
+	results, err := h.BatchOrders(orders)
+	if !errors.Is(err, hbdm.ErrSystemMaintenance) {
+		t.Fatalf("got error %v, want %v", err, hbdm.ErrSystemMaintenance)
+	}
+
+	// orders of failed chunk may be placed, orders of next chunk are not sent
+	for i, r := range results {
+		if i < 10 && r.Err != err {
+			t.Errorf("result %d: got error %v, want request error", i, r.Err)
+		}
+		if i >= 10 && r.Err != nil {
+			t.Errorf("result %d: got error %v for not sent order", i, r.Err)
+		}
+	}
+
+	if n := len(srv.Requests()); n != 1 {
+		t.Errorf("got %d requests, want 1", n)
+	}
+}
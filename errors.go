@@ -0,0 +1,171 @@
+package hbdm
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// Sentinel errors for classification of hbdm API failures, use it with errors.Is
+var (
+	ErrInsufficientMargin = errors.New("hbdm: insufficient margin")
+	ErrOrderNotFound      = errors.New("hbdm: order not found")
+	ErrRateLimited        = errors.New("hbdm: rate limited")
+	ErrAuth               = errors.New("hbdm: signature or authentication failure")
+	ErrSystemMaintenance  = errors.New("hbdm: system maintenance")
+
+	// ErrInsufficientCloseAmount is returned when close volume exceeds available position,
+	// it's not a margin shortage
+	ErrInsufficientCloseAmount = errors.New("hbdm: insufficient close amount available")
+
+	// ErrDuplicateOrder is returned when order with the same client order id was already placed
+	ErrDuplicateOrder = errors.New("hbdm: duplicate client order id")
+)
+
+// ErrInvalidOrder is returned when order parameters validation failed before sending
+var ErrInvalidOrder = errors.New("hbdm: invalid order")
+
+// errClasses maps hbdm error codes to sentinel errors
+var errClasses = map[int]error{
+	1047: ErrInsufficientMargin,
+
+	1048: ErrInsufficientCloseAmount,
+
+	1050: ErrDuplicateOrder,
+
+	1017: ErrOrderNotFound,
+	1061: ErrOrderNotFound,
+	1071: ErrOrderNotFound,
+
+	1032: ErrRateLimited,
+
+	403:   ErrAuth,
+	1010:  ErrAuth,
+	1011:  ErrAuth,
+	1200:  ErrAuth,
+	1220:  ErrAuth,
+	12001: ErrAuth,
+	12002: ErrAuth,
+	12003: ErrAuth,
+	12004: ErrAuth,
+	12005: ErrAuth,
+	12006: ErrAuth,
+	12007: ErrAuth,
+	12008: ErrAuth,
+	12009: ErrAuth,
+
+	1000: ErrSystemMaintenance,
+	1001: ErrSystemMaintenance,
+	1003: ErrSystemMaintenance,
+	1004: ErrSystemMaintenance,
+}
+
+// reasonClasses maps Huobi spot API text error codes to sentinel errors
+var reasonClasses = map[string]error{
+	"api-signature-not-valid": ErrAuth,
+}
+
+// APIError is error returned by hbdm API
+type APIError struct {
+	Status     string `json:"status"`
+	Code       int    `json:"err_code"`
+	Message    string `json:"err_msg"`
+	Ts         int64  `json:"ts"`
+	Reason     string `json:"-"` // text error code returned by Huobi spot API
+	Endpoint   string `json:"-"`
+	HTTPStatus int    `json:"-"`
+}
+
+// apiErrorBody is error response of hbdm and Huobi spot API
+type apiErrorBody struct {
+	APIError
+	SpotCode    string `json:"err-code"`
+	SpotMessage string `json:"err-msg"`
+}
+
+// Error implements error interface
+func (e *APIError) Error() string {
+	if e.Code == 0 && e.Reason != "" {
+		return fmt.Sprintf("hbdm %s: error %s: %s", e.Endpoint, e.Reason, e.Message)
+	}
+	if e.Code == 0 {
+		return fmt.Sprintf("hbdm %s: http %d: %s", e.Endpoint, e.HTTPStatus, e.Message)
+	}
+	return fmt.Sprintf("hbdm %s: error %d: %s", e.Endpoint, e.Code, e.Message)
+}
+
+// Is reports whether APIError belongs to given sentinel error class
+func (e *APIError) Is(target error) bool {
+	return target != nil && e.class() == target
+}
+
+// class returns sentinel error for APIError code or HTTP status
+func (e *APIError) class() error {
+	if class, ok := errClasses[e.Code]; ok {
+		return class
+	}
+
+	if class, ok := reasonClasses[e.Reason]; ok {
+		return class
+	}
+
+	switch e.HTTPStatus {
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return ErrAuth
+	case http.StatusTooManyRequests:
+		return ErrRateLimited
+	case http.StatusBadGateway, http.StatusServiceUnavailable:
+		return ErrSystemMaintenance
+	}
+
+	return nil
+}
+
+// Response is common envelope of hbdm API responses, typed responses embed it next to their data
+type Response struct {
+	Status  string `json:"status"`
+	ErrCode int    `json:"err_code,omitempty"`
+	ErrMsg  string `json:"err_msg,omitempty"`
+	Ts      int    `json:"ts"`
+}
+
+// Envelope returns response envelope
+func (r *Response) Envelope() *Response {
+	return r
+}
+
+// Enveloper is typed API response with common envelope, it's satisfied by embedding Response
+type Enveloper interface {
+	Envelope() *Response
+}
+
+// handleErr gets JSON response from hbdm API and deal with error
+func handleErr(endpoint string, httpStatus int, body []byte) error {
+	var errBody apiErrorBody
+
+	if err := json.Unmarshal(body, &errBody); err != nil {
+		if httpStatus != http.StatusOK {
+			return &APIError{Endpoint: endpoint, HTTPStatus: httpStatus, Message: http.StatusText(httpStatus)}
+		}
+		return fmt.Errorf("hbdm %s: unmarshalling response: %v", endpoint, err)
+	}
+
+	apiErr := errBody.APIError
+	if errBody.SpotCode != "" {
+		apiErr.Reason = errBody.SpotCode
+		apiErr.Message = errBody.SpotMessage
+	}
+
+	if apiErr.Status != "error" && apiErr.Code == 0 && apiErr.Reason == "" && httpStatus == http.StatusOK {
+		return nil
+	}
+
+	apiErr.Endpoint = endpoint
+	apiErr.HTTPStatus = httpStatus
+	if apiErr.Message == "" {
+		apiErr.Message = http.StatusText(httpStatus)
+	}
+
+	return &apiErr
+}
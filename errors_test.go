@@ -0,0 +1,57 @@
+package hbdm_test
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/andskur/hbdm-go"
+	"github.com/andskur/hbdm-go/hbdmtest"
+)
+
+func TestAPIErrorClasses(t *testing.T) {
+	srv := hbdmtest.NewServer("key", "secret")
+	defer srv.Close()
+
+	h := hbdm.New("key", "secret",
+		hbdm.WithEndpoints(srv.Endpoints()),
+		hbdm.WithRetryPolicy(hbdm.RetryPolicy{}),
+	)
+
+	tests := []struct {
+		code int
+		want error
+	}{
+		{1047, hbdm.ErrInsufficientMargin},
+		{1048, hbdm.ErrInsufficientCloseAmount},
+		{1017, hbdm.ErrOrderNotFound},
+		{1032, hbdm.ErrRateLimited},
+		{1010, hbdm.ErrAuth},
+		{1004, hbdm.ErrSystemMaintenance},
+	}
+
+	for _, tt := range tests {
+		srv.HandleError("/api/v1/contract_account_info", tt.code, "error")
+
+		_, err := h.AccountInfo("BTC")
+		if !errors.Is(err, tt.want) {
+			t.Errorf("code %d: got error %v, want %v", tt.code, err, tt.want)
+		}
+
+		var apiErr *hbdm.APIError
+		if !errors.As(err, &apiErr) || apiErr.Code != tt.code || apiErr.Endpoint != "contract_account_info" {
+			t.Errorf("code %d: unexpected API error %#v", tt.code, err)
+		}
+	}
+
+	// 1048 is close volume error, not margin shortage
+	srv.HandleError("/api/v1/contract_account_info", 1048, "error")
+	if _, err := h.AccountInfo("BTC"); errors.Is(err, hbdm.ErrInsufficientMargin) {
+		t.Errorf("code 1048 is classified as %v", hbdm.ErrInsufficientMargin)
+	}
+
+	srv.Handle("/api/v1/contract_account_info", http.StatusServiceUnavailable, "")
+	if _, err := h.AccountInfo("BTC"); !errors.Is(err, hbdm.ErrSystemMaintenance) {
+		t.Errorf("http 503: got error %v, want %v", err, hbdm.ErrSystemMaintenance)
+	}
+}
@@ -0,0 +1,99 @@
+package hbdm
+
+import (
+	"context"
+
+	"github.com/shopspring/decimal"
+)
+
+// FinancialRecordsResponse is response from FinancialRecords method
+type FinancialRecordsResponse struct {
+	Response
+	Data struct {
+		FinancialRecord []FinancialRecord `json:"financial_record"`
+		Pagination
+	} `json:"data"`
+}
+
+// FinancialRecord is account ledger record data model
+type FinancialRecord struct {
+	Id     int64           `json:"id"`
+	Ts     int64           `json:"ts"`
+	Symbol string          `json:"symbol"`
+	Type   int             `json:"type"`
+	Amount decimal.Decimal `json:"amount"`
+}
+
+// FinancialRecords get account ledger records by given filters, recordType is comma separated
+// record types like "3,4" or empty for all, createDate is number of days
+func (h *Hbdm) FinancialRecords(symbol, recordType string, createDate int, pageIndex, pageSize *int) (records *FinancialRecordsResponse, err error) {
+	return h.FinancialRecordsCtx(context.Background(), symbol, recordType, createDate, pageIndex, pageSize)
+}
+
+// FinancialRecordsCtx is FinancialRecords with context for request cancellation and deadlines
+func (h *Hbdm) FinancialRecordsCtx(ctx context.Context, symbol, recordType string, createDate int, pageIndex, pageSize *int) (records *FinancialRecordsResponse, err error) {
+	payload := make(Params, 5)
+	payload.Set("symbol", symbol)
+
+	if recordType != "" {
+		payload.Set("type", recordType)
+	}
+	if createDate != 0 {
+		payload.Set("create_date", createDate)
+	}
+	if pageIndex != nil {
+		payload.Set("page_index", *pageIndex)
+	}
+	if pageSize != nil {
+		payload.Set("page_size", *pageSize)
+	}
+
+	records = new(FinancialRecordsResponse)
+	if err = h.client.call(ctx, "POST", "contract_financial_record", payload, true, true, records); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// FinancialRecordIterator iterates over financial records of all pages
+type FinancialRecordIterator struct {
+	pager
+	records []FinancialRecord
+}
+
+// Next advances iterator to next record, it returns false when records are over or error occurred
+func (it *FinancialRecordIterator) Next() bool {
+	return it.next()
+}
+
+// Record returns current record
+func (it *FinancialRecordIterator) Record() FinancialRecord {
+	return it.records[it.pos]
+}
+
+// IterateFinancialRecords returns iterator over financial records of all pages by given filters
+func (h *Hbdm) IterateFinancialRecords(ctx context.Context, symbol, recordType string, createDate int, opts PageOptions) *FinancialRecordIterator {
+	it := &FinancialRecordIterator{}
+	it.pager = pager{
+		ctx:  ctx,
+		opts: opts,
+		fetch: func(ctx context.Context, pageIndex, pageSize *int) (int, Pagination, error) {
+			resp, err := h.FinancialRecordsCtx(ctx, symbol, recordType, createDate, pageIndex, pageSize)
+			if err != nil {
+				return 0, Pagination{}, err
+			}
+			it.records = resp.Data.FinancialRecord
+			return len(it.records), resp.Data.Pagination, nil
+		},
+	}
+	return it
+}
+
+// AllFinancialRecords get financial records of all pages by given filters
+func (h *Hbdm) AllFinancialRecords(ctx context.Context, symbol, recordType string, createDate int, opts PageOptions) (records []FinancialRecord, err error) {
+	it := h.IterateFinancialRecords(ctx, symbol, recordType, createDate, opts)
+	for it.Next() {
+		records = append(records, it.Record())
+	}
+	return records, it.Err()
+}
@@ -1,10 +1,10 @@
 module github.com/andskur/hbdm-go
 
-go 1.12
+go 1.13
 
 require (
-	github.com/davecgh/go-spew v1.1.1
 	github.com/gofrs/uuid v3.2.0+incompatible
 	github.com/gorilla/websocket v1.4.0
-	github.com/sirupsen/logrus v1.4.1 // indirect
+	github.com/shopspring/decimal v1.3.1
+	github.com/sirupsen/logrus v1.4.1
 )
@@ -1,274 +1,332 @@
 package hbdm
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
-	"sync"
+	"strconv"
+	"strings"
 	"time"
 
-	"github.com/davecgh/go-spew/spew"
+	"github.com/shopspring/decimal"
 )
 
 // hbdm API base url
+//
+// Deprecated: use DefaultEndpoints.REST, contract API methods are under /api/v1 path of it
 const API_BASE = "https://api.hbdm.com/api/v1"
 
-// New returns an instantiated hbdm struct
-func New(apiKey, apiSecret string) *Hbdm {
-	client := NewHttpClient(apiKey, apiSecret)
-	return &Hbdm{client, sync.Mutex{}}
+// New returns an instantiated hbdm struct configured with given options
+func New(apiKey, apiSecret string, opts ...Option) *Hbdm {
+	h := &Hbdm{
+		client: NewHttpClient(apiKey, apiSecret),
+		nonce:  NewFileNonceStore(defaultNonceFile),
+	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
 }
 
 // NewWithCustomHttpClient returns an instantiated hbdm struct with custom http client
 func NewWithCustomHttpClient(apiKey, apiSecret string, httpClient *http.Client) *Hbdm {
-	client := NewHttpClientWithCustomHttpConfig(apiKey, apiSecret, httpClient)
-	return &Hbdm{client, sync.Mutex{}}
+	return New(apiKey, apiSecret, WithHttpClient(httpClient))
 }
 
 // NewWithCustomTimeout returns an instantiated hbdm struct with custom timeout
 func NewWithCustomTimeout(apiKey, apiSecret string, timeout time.Duration) *Hbdm {
-	client := NewHttpClientWithCustomTimeout(apiKey, apiSecret, timeout)
-	return &Hbdm{client, sync.Mutex{}}
+	return New(apiKey, apiSecret, WithTimeout(timeout))
 }
 
-// handleErr gets JSON response from livecoin API en deal with error
-func handleErr(r interface{}) error {
-	switch v := r.(type) {
-	case map[string]interface{}:
-		code, ok := v["err_code"]
-		if !ok {
-			return nil
-		}
+// Hbdm represent a hbdm client
+type Hbdm struct {
+	client *client
+	nonce  NonceStore
+}
 
-		err, ok := v["err_msg"]
-		if !ok {
-			return nil
-		}
+// Endpoints returns API endpoints client configured with
+func (h *Hbdm) Endpoints() Endpoints {
+	return h.client.endpoints
+}
 
-		return fmt.Errorf("error %g: %v", code, err)
-	default:
-		return fmt.Errorf("I don't know about type %T!\n", v)
-	}
+// Call process request to given API resource with client signing and error handling and
+// decodes response into given typed result, resource is a path from REST API root like
+// "/swap-api/v1/swap_order" or contract API method name. Only idempotent requests are
+// retried on transient failures, pass false for order placement, cancellation and transfers
+func (h *Hbdm) Call(ctx context.Context, method, resource string, payload Params, authNeeded, idempotent bool, result Enveloper) error {
+	return h.client.call(ctx, method, resource, payload, authNeeded, idempotent, result)
 }
 
-// Hbdm represent a hbdm client
-type Hbdm struct {
-	client *client
-	mu     sync.Mutex
+// Logger returns logger client configured with
+func (h *Hbdm) Logger() Logger {
+	return h.client.logger
 }
 
-// SetDebug sets enable/disable http request/response dump
+// SetDebug sets enable/disable http request/response dump, dumps are written to client
+// logger on debug level with redacted credentials
 func (h *Hbdm) SetDebug(enable bool) {
 	h.client.debug = enable
 }
 
 // ContractIndexResponse is response for ContactIndex method
 type ContractIndexResponse struct {
-	Status string            `json:"status"`
-	Ts     int               `json:"ts"`
-	Data   ContractIndexData `json:"data"`
+	Response
+	Data ContractIndexData `json:"data"`
 }
 
 // ContractIndexData is data field in Contract Index method response
 type ContractIndexData struct {
-	Symbol string  `json:"symbol"`
-	Price  float64 `json:"index_price"`
-	Ts     int     `json:"index_ts"`
+	Symbol string          `json:"symbol"`
+	Price  decimal.Decimal `json:"index_price"`
+	Ts     int             `json:"index_ts"`
 }
 
 // UnmarshalJSON process correct json Unmarrshaling for ContractIndexData struct
 func (c *ContractIndexData) UnmarshalJSON(b []byte) (err error) {
-	var resp []map[string]interface{}
+	// plain type without UnmarshalJSON method
+	type contractIndexData ContractIndexData
+	var resp []contractIndexData
 
 	if err := json.Unmarshal(b, &resp); err != nil {
 		return fmt.Errorf("unmarshalling: %v", err)
 	}
 
-	c.Symbol = resp[0]["symbol"].(string)
-	c.Price = resp[0]["index_price"].(float64)
-	c.Ts = int(resp[0]["index_ts"].(float64))
+	// data is empty in error responses
+	if len(resp) == 0 {
+		return
+	}
 
+	*c = ContractIndexData(resp[0])
 	return
 }
 
 // ContractIndex Get Contract Index Price Information
 func (h *Hbdm) ContractIndex(symbol string) (index *ContractIndexResponse, err error) {
-	payload := make(map[string]interface{}, 1)
-	payload["symbol"] = symbol
+	return h.ContractIndexCtx(context.Background(), symbol)
+}
 
-	r, err := h.client.do("GET", "contract_index", payload, false)
-	if err != nil {
-		return
-	}
-	var response interface{}
-	if err = json.Unmarshal(r, &response); err != nil {
-		return
-	}
-	if err = handleErr(response); err != nil {
-		return
-	}
+// ContractIndexCtx is ContractIndex with context for request cancellation and deadlines
+func (h *Hbdm) ContractIndexCtx(ctx context.Context, symbol string) (index *ContractIndexResponse, err error) {
+	payload := make(Params, 1)
+	payload.Set("symbol", symbol)
 
-	err = json.Unmarshal(r, &index)
+	index = new(ContractIndexResponse)
+	if err = h.client.call(ctx, "GET", "contract_index", payload, false, true, index); err != nil {
+		return nil, err
+	}
 	return
 }
 
 // AccountInfoResponse is response for ContactIndex method
 type AccountInfoResponse struct {
-	Status string            `json:"status"`
-	Ts     int               `json:"ts"`
-	Data   []AccountInfoData `json:"data"`
+	Response
+	Data []AccountInfoData `json:"data"`
 }
 
 // AccountInfoData is data field in Account Info method response
 type AccountInfoData struct {
-	Symbol            string  `json:"symbol"`
-	MarginBalance     float64 `json:"margin_balance"`
-	MarginPosition    float64 `json:"margin_position"`
-	MarginFrozen      float64 `json:"margin_frozen"`
-	MarginAvailable   float64 `json:"margin_available"`
-	ProfitReal        float64 `json:"profit_real"`
-	ProfitUnreal      float64 `json:"profit_unreal"`
-	WithdrawAvailable float64 `json:"withdraw_available"`
-	RiskRate          float64 `json:"risk_rate"`
-	LiquidationPrice  float64 `json:"liquidation_price"`
+	Symbol            string          `json:"symbol"`
+	MarginBalance     decimal.Decimal `json:"margin_balance"`
+	MarginPosition    decimal.Decimal `json:"margin_position"`
+	MarginFrozen      decimal.Decimal `json:"margin_frozen"`
+	MarginAvailable   decimal.Decimal `json:"margin_available"`
+	ProfitReal        decimal.Decimal `json:"profit_real"`
+	ProfitUnreal      decimal.Decimal `json:"profit_unreal"`
+	WithdrawAvailable decimal.Decimal `json:"withdraw_available"`
+	RiskRate          decimal.Decimal `json:"risk_rate"`
+	LiquidationPrice  decimal.Decimal `json:"liquidation_price"`
 }
 
 // AccountInfo return User’s Account Information
 func (h *Hbdm) AccountInfo(symbol string) (info *AccountInfoResponse, err error) {
-	payload := make(map[string]interface{}, 1)
-	if symbol != "" {
-		payload["symbol"] = symbol
-	}
-
-	r, err := h.client.do("POST", "contract_account_info", payload, true)
-	if err != nil {
-		return
-	}
+	return h.AccountInfoCtx(context.Background(), symbol)
+}
 
-	var response interface{}
-	if err = json.Unmarshal(r, &response); err != nil {
-		return
+// AccountInfoCtx is AccountInfo with context for request cancellation and deadlines
+func (h *Hbdm) AccountInfoCtx(ctx context.Context, symbol string) (info *AccountInfoResponse, err error) {
+	payload := make(Params, 1)
+	if symbol != "" {
+		payload.Set("symbol", symbol)
 	}
 
-	if err = handleErr(response); err != nil {
-		return
+	info = new(AccountInfoResponse)
+	if err = h.client.call(ctx, "POST", "contract_account_info", payload, true, true, info); err != nil {
+		return nil, err
 	}
-
-	err = json.Unmarshal(r, &info)
 	return
 }
 
 // ContractPositionResponse is response from PositionInfo method
 type ContractPositionResponse struct {
-	Status string                 `json:"status"`
-	Data   []ContractPositionData `json:"data"`
-	Ts     int                    `json:"ts"`
+	Response
+	Data []ContractPositionData `json:"data"`
 }
 
 // ContractPositionData is Position data model
 type ContractPositionData struct {
-	Symbol         string  `json:"symbol"`
-	ContractType   string  `json:"contract_type"`
-	ContractCode   string  `json:"contract_code"`
-	Volume         float64 `json:"volume"`
-	Price          float64 `json:"price"`
-	Available      float64 `json:"available"`
-	Frozen         float64 `json:"frozen"`
-	CostOpen       float64 `json:"cost_open"`
-	CostHold       float64 `json:"cost_hold"`
-	ProfitUnreal   float64 `json:"profit_unreal"`
-	ProfitRate     float64 `json:"profit_rate"`
-	Profit         float64 `json:"profit"`
-	PositionMargin float64 `json:"position_margin"`
-	LevelRate      int     `json:"level_rate"`
-	Direction      string  `json:"direction"`
+	Symbol         string          `json:"symbol"`
+	ContractType   string          `json:"contract_type"`
+	ContractCode   string          `json:"contract_code"`
+	Volume         decimal.Decimal `json:"volume"`
+	Price          decimal.Decimal `json:"price"`
+	Available      decimal.Decimal `json:"available"`
+	Frozen         decimal.Decimal `json:"frozen"`
+	CostOpen       decimal.Decimal `json:"cost_open"`
+	CostHold       decimal.Decimal `json:"cost_hold"`
+	ProfitUnreal   decimal.Decimal `json:"profit_unreal"`
+	ProfitRate     decimal.Decimal `json:"profit_rate"`
+	Profit         decimal.Decimal `json:"profit"`
+	PositionMargin decimal.Decimal `json:"position_margin"`
+	LevelRate      int             `json:"level_rate"`
+	Direction      string          `json:"direction"`
 }
 
 // PositionInfo Get Account open position
 func (h *Hbdm) PositionInfo(symbol string) (positions *ContractPositionResponse, err error) {
-	payload := make(map[string]interface{}, 1)
-	if symbol != "" {
-		payload["symbol"] = symbol
-	}
-
-	r, err := h.client.do("POST", "contract_position_info", payload, true)
-	if err != nil {
-		return
-	}
+	return h.PositionInfoCtx(context.Background(), symbol)
+}
 
-	var response interface{}
-	if err = json.Unmarshal(r, &response); err != nil {
-		return
+// PositionInfoCtx is PositionInfo with context for request cancellation and deadlines
+func (h *Hbdm) PositionInfoCtx(ctx context.Context, symbol string) (positions *ContractPositionResponse, err error) {
+	payload := make(Params, 1)
+	if symbol != "" {
+		payload.Set("symbol", symbol)
 	}
 
-	if err = handleErr(response); err != nil {
-		return
+	positions = new(ContractPositionResponse)
+	if err = h.client.call(ctx, "POST", "contract_position_info", payload, true, true, positions); err != nil {
+		return nil, err
 	}
-
-	err = json.Unmarshal(r, &positions)
 	return
-
 }
 
 // ContractOrderResponse is response from ContractOder method
 type ContractOrderResponse struct {
-	Status string            `json:"status"`
-	Data   ContractOrderData `json:"data"`
-	Ts     int               `json:"ts"`
+	Response
+	Data ContractOrderData `json:"data"`
 }
 
 // ContractOrderData is ContractOrder method response data
 type ContractOrderData struct {
-	OrderId       float64 `json:"order_id"`
-	ClientOrderId float64 `json:"client_order_id"`
+	OrderId       int64 `json:"order_id"`
+	ClientOrderId int64 `json:"client_order_id"`
 }
 
-// ContractOder place order for open or close contract position
-func (h *Hbdm) ContractOder(symbol, contractType, contractCode, direction, offset, priceType string, price float64, volume, levelRate int) (order *ContractOrderResponse, err error) {
+// PlaceOrder validates and places order for open or close contract position, order with
+// supplied ClientOrderId is retried on transient failures if it's not found by OrderInfo.
+// ErrDuplicateOrder after retry means order was placed but it's not found by OrderInfo yet
+func (h *Hbdm) PlaceOrder(order OrderRequest) (resp *ContractOrderResponse, err error) {
+	return h.PlaceOrderCtx(context.Background(), order)
+}
 
-	orderId, err := h.GetAndIncrementNonce()
-	if err != nil {
-		return nil, err
+// PlaceOrderCtx is PlaceOrder with context for request cancellation and deadlines
+func (h *Hbdm) PlaceOrderCtx(ctx context.Context, order OrderRequest) (resp *ContractOrderResponse, err error) {
+	if err = order.Validate(); err != nil {
+		return
 	}
 
-	payload := make(map[string]interface{}, 9)
-	payload["symbol"] = symbol
-	payload["contract_type"] = contractType
-	payload["client_order_id"] = orderId
-	payload["price"] = price
-	payload["volume"] = volume
-	payload["direction"] = direction
-	payload["offset"] = offset
-	payload["lever_rate"] = levelRate
-	payload["order_price_type"] = priceType
+	// only orders with caller supplied client order id can be safely retried,
+	// generated id is lost for caller if order placement result is unknown
+	retry := order.ClientOrderId != 0
+	if !retry {
+		if order.ClientOrderId, err = h.GetAndIncrementNonce(); err != nil {
+			return
+		}
+	}
 
-	if contractCode != "" {
-		payload["contract_code"] = contractCode
+	payload := order.payload()
+	for attempt := 1; ; attempt++ {
+		resp = new(ContractOrderResponse)
+		err = h.client.call(ctx, "POST", "contract_order", payload, true, false, resp)
+
+		// duplicate client order id on retry means that one of previous attempts placed order
+		if attempt > 1 && errors.Is(err, ErrDuplicateOrder) {
+			placed, infoErr := h.placedOrder(ctx, order)
+			if infoErr == nil && placed != nil {
+				return placed, nil
+			}
+			break
+		}
+
+		if !retry || !h.client.retry.retry(ctx, attempt, err) {
+			break
+		}
+
+		h.client.logger.Warn("hbdm order retry", "client_order_id", order.ClientOrderId, "attempt", attempt, "err", err)
+		if h.client.retry.wait(ctx, attempt) != nil {
+			break
+		}
+
+		// order may be placed despite of failure, check it before placing again
+		placed, infoErr := h.placedOrder(ctx, order)
+		if infoErr != nil {
+			break
+		}
+		if placed != nil {
+			return placed, nil
+		}
 	}
 
-	r, err := h.client.do("POST", "contract_order", payload, true)
 	if err != nil {
-		return
+		return nil, err
 	}
+	return
+}
 
-	var response interface{}
-	if err = json.Unmarshal(r, &response); err != nil {
-		return
+// placedOrder returns placement result of order with client order id if it exists on exchange
+func (h *Hbdm) placedOrder(ctx context.Context, order OrderRequest) (*ContractOrderResponse, error) {
+	symbol := order.Symbol
+	if symbol == "" {
+		symbol = strings.TrimRight(order.ContractCode, "0123456789")
 	}
 
-	if err = handleErr(response); err != nil {
-		return
+	info, err := h.OrderInfoCtx(ctx, "", strconv.FormatInt(order.ClientOrderId, 10), symbol)
+	if errors.Is(err, ErrOrderNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(info.Data) == 0 {
+		return nil, nil
 	}
 
-	err = json.Unmarshal(r, &order)
-	return
+	resp := &ContractOrderResponse{Response: info.Response}
+	resp.Data.OrderId = info.Data[0].OrderId
+	resp.Data.ClientOrderId = info.Data[0].ClientOrderId
+	return resp, nil
+}
+
+// ContractOder place order for open or close contract position
+//
+// Deprecated: use PlaceOrder, typed OrderRequest prevents mixing up positional arguments
+func (h *Hbdm) ContractOder(symbol, contractType, contractCode, direction, offset, priceType string, price float64, volume, levelRate int) (order *ContractOrderResponse, err error) {
+	return h.ContractOderCtx(context.Background(), symbol, contractType, contractCode, direction, offset, priceType, price, volume, levelRate)
+}
+
+// ContractOderCtx is ContractOder with context for request cancellation and deadlines
+//
+// Deprecated: use PlaceOrderCtx
+func (h *Hbdm) ContractOderCtx(ctx context.Context, symbol, contractType, contractCode, direction, offset, priceType string, price float64, volume, levelRate int) (order *ContractOrderResponse, err error) {
+	return h.PlaceOrderCtx(ctx, OrderRequest{
+		Symbol:         symbol,
+		ContractType:   ContractType(contractType),
+		ContractCode:   contractCode,
+		Price:          decimal.NewFromFloat(price),
+		Volume:         volume,
+		Direction:      Direction(direction),
+		Offset:         Offset(offset),
+		LeverRate:      levelRate,
+		OrderPriceType: OrderPriceType(priceType),
+	})
 }
 
 type CancelOrderResponse struct {
-	Status    string             `json:"status"`
+	Response
 	Errors    []CancelOrderError `json:"errors"`
 	Successes []string           `json:"successes"`
-	Ts        int                `json:"ts"`
 }
 
 type CancelOrderError struct {
@@ -278,42 +336,34 @@ type CancelOrderError struct {
 }
 
 // CancelAllOrders cancel all user orders for given symbol
-func (h *Hbdm) CanceOrder(symbol string, orderId, clientOrderId int) (resp *CancelOrderResponse, err error) {
-	payload := make(map[string]interface{}, 3)
-	payload["symbol"] = symbol
+func (h *Hbdm) CanceOrder(symbol string, orderId, clientOrderId int64) (resp *CancelOrderResponse, err error) {
+	return h.CanceOrderCtx(context.Background(), symbol, orderId, clientOrderId)
+}
+
+// CanceOrderCtx is CanceOrder with context for request cancellation and deadlines
+func (h *Hbdm) CanceOrderCtx(ctx context.Context, symbol string, orderId, clientOrderId int64) (resp *CancelOrderResponse, err error) {
+	payload := make(Params, 3)
+	payload.Set("symbol", symbol)
 
 	if orderId != 0 {
-		payload["order_id"] = orderId
+		payload.Set("order_id", orderId)
 	}
 
 	if clientOrderId != 0 {
-		payload["client_order_id"] = clientOrderId
-	}
-
-	r, err := h.client.do("POST", "contract_cancel", payload, true)
-	if err != nil {
-		return
+		payload.Set("client_order_id", clientOrderId)
 	}
 
-	var response interface{}
-	if err = json.Unmarshal(r, &response); err != nil {
-		return
-	}
-
-	if err = handleErr(response); err != nil {
-		return
+	resp = new(CancelOrderResponse)
+	if err = h.client.call(ctx, "POST", "contract_cancel", payload, true, false, resp); err != nil {
+		return nil, err
 	}
-
-	err = json.Unmarshal(r, &resp)
 	return
-
 }
 
 // CancelAllOrdersResponse is response from CancelAllOrders method
 type CancelAllOrdersResponse struct {
-	Status string                `json:"status"`
-	Data   []CancelAllOrdersData `json:"data"`
-	Ts     int                   `json:"ts"`
+	Response
+	Data []CancelAllOrdersData `json:"data"`
 }
 
 // CancelAllOrdersData is CancelAllOrdersData method response data
@@ -325,162 +375,200 @@ type CancelAllOrdersData struct {
 
 // CancelAllOrders cancel all user orders for given symbol
 func (h *Hbdm) CancelAllOrders(symbol string) (resp *CancelAllOrdersResponse, err error) {
-	payload := make(map[string]interface{}, 1)
-	payload["symbol"] = symbol
-
-	r, err := h.client.do("POST", "contract_cancelall", payload, true)
-	if err != nil {
-		return
-	}
+	return h.CancelAllOrdersCtx(context.Background(), symbol)
+}
 
-	var response interface{}
-	if err = json.Unmarshal(r, &response); err != nil {
-		return
-	}
+// CancelAllOrdersCtx is CancelAllOrders with context for request cancellation and deadlines
+func (h *Hbdm) CancelAllOrdersCtx(ctx context.Context, symbol string) (resp *CancelAllOrdersResponse, err error) {
+	payload := make(Params, 1)
+	payload.Set("symbol", symbol)
 
-	if err = handleErr(response); err != nil {
-		return
+	resp = new(CancelAllOrdersResponse)
+	if err = h.client.call(ctx, "POST", "contract_cancelall", payload, true, false, resp); err != nil {
+		return nil, err
 	}
-
-	err = json.Unmarshal(r, &resp)
 	return
-
 }
 
 // OrderInfoResponse is response for OrderInfo method
 type OrderInfoResponse struct {
-	Status string          `json:"status"`
-	Ts     int             `json:"ts"`
-	Data   []OrderInfoData `json:"data"`
+	Response
+	Data []OrderInfoData `json:"data"`
 }
 
 // OrderInfoData id Order data model
 type OrderInfoData struct {
-	Symbol        string  `json:"symbol"`
-	ContractType  string  `json:"contract_type"`
-	ContractCode  string  `json:"contract_code"`
-	Volume        float64 `json:"volume"`
-	Price         float64 `json:"price"`
-	PriceType     string  `json:"order_price_type"`
-	Direction     string  `json:"direction"`
-	Offset        string  `json:"offset"`
-	LevelRate     int     `json:"level_rate"`
-	OrderId       int     `json:"order_id"`
-	ClientOrderId int     `json:"client_order_id"`
-	OrderSource   string  `json:"order_source"`
-	CreatedAt     int     `json:"created_at"`
-	TradeVolume   float64 `json:"trade_volume"`
-	TradeTurnover float64 `json:"trade_turnover"`
-	Fee           float64 `json:"fee"`
-	TradeAvgPrice float64 `json:"trade_avg_price"`
-	MarginFrozen  float64 `json:"margin_frozen"`
-	Profit        float64 `json:"profit"`
-	Status        int     `json:"status"`
+	Symbol        string          `json:"symbol"`
+	ContractType  string          `json:"contract_type"`
+	ContractCode  string          `json:"contract_code"`
+	Volume        decimal.Decimal `json:"volume"`
+	Price         decimal.Decimal `json:"price"`
+	PriceType     string          `json:"order_price_type"`
+	Direction     string          `json:"direction"`
+	Offset        string          `json:"offset"`
+	LevelRate     int             `json:"level_rate"`
+	OrderId       int64           `json:"order_id"`
+	ClientOrderId int64           `json:"client_order_id"`
+	OrderSource   string          `json:"order_source"`
+	CreatedAt     int             `json:"created_at"`
+	TradeVolume   decimal.Decimal `json:"trade_volume"`
+	TradeTurnover decimal.Decimal `json:"trade_turnover"`
+	Fee           decimal.Decimal `json:"fee"`
+	TradeAvgPrice decimal.Decimal `json:"trade_avg_price"`
+	MarginFrozen  decimal.Decimal `json:"margin_frozen"`
+	Profit        decimal.Decimal `json:"profit"`
+	Status        int             `json:"status"`
 }
 
 // OrderInfo get Order info by given order ID for providing Symbol
 func (h *Hbdm) OrderInfo(orderId, clientOrderId, symbol string) (orders *OrderInfoResponse, err error) {
-	payload := make(map[string]interface{}, 3)
+	return h.OrderInfoCtx(context.Background(), orderId, clientOrderId, symbol)
+}
+
+// OrderInfoCtx is OrderInfo with context for request cancellation and deadlines
+func (h *Hbdm) OrderInfoCtx(ctx context.Context, orderId, clientOrderId, symbol string) (orders *OrderInfoResponse, err error) {
+	payload := make(Params, 3)
 	if symbol != "" {
-		payload["symbol"] = symbol
+		payload.Set("symbol", symbol)
 	}
 	if orderId != "" {
-		payload["order_id"] = orderId
+		payload.Set("order_id", orderId)
 	}
 	if clientOrderId != "" {
-		payload["client_order_id"] = clientOrderId
-	}
-
-	spew.Dump(payload)
-
-	r, err := h.client.do("POST", "contract_order_info", payload, true)
-	if err != nil {
-		return
+		payload.Set("client_order_id", clientOrderId)
 	}
 
-	var response interface{}
-	if err = json.Unmarshal(r, &response); err != nil {
-		return
-	}
-
-	if err = handleErr(response); err != nil {
-		return
+	orders = new(OrderInfoResponse)
+	if err = h.client.call(ctx, "POST", "contract_order_info", payload, true, true, orders); err != nil {
+		return nil, err
 	}
-
-	err = json.Unmarshal(r, &orders)
 	return
 }
 
 // OrdersResponse is mutual response for Orders arrays methods - Open, History
 type OrdersResponse struct {
-	Status string `json:"status"`
-	Ts     int    `json:"ts"`
-	Data   struct {
+	Response
+	Data struct {
 		Orders []OrderInfoData `json:"orders"`
+		Pagination
 	} `json:"data"`
 }
 
 // OpenOrders get all open orders
 func (h *Hbdm) OpenOrders(symbol string, pageIndex, pageSize *int) (orders *OrdersResponse, err error) {
-	payload := make(map[string]interface{}, 3)
+	return h.OpenOrdersCtx(context.Background(), symbol, pageIndex, pageSize)
+}
+
+// OpenOrdersCtx is OpenOrders with context for request cancellation and deadlines
+func (h *Hbdm) OpenOrdersCtx(ctx context.Context, symbol string, pageIndex, pageSize *int) (orders *OrdersResponse, err error) {
+	payload := make(Params, 3)
 	if symbol != "" {
-		payload["symbol"] = symbol
+		payload.Set("symbol", symbol)
 	}
 	if pageIndex != nil {
-		payload["page_index"] = *pageIndex
+		payload.Set("page_index", *pageIndex)
 	}
 	if pageSize != nil {
-		payload["page_size"] = *pageSize
-	}
-
-	r, err := h.client.do("POST", "contract_openorders", payload, true)
-	if err != nil {
-		return
-	}
-
-	var response interface{}
-	if err = json.Unmarshal(r, &response); err != nil {
-		return
+		payload.Set("page_size", *pageSize)
 	}
 
-	if err = handleErr(response); err != nil {
-		return
+	orders = new(OrdersResponse)
+	if err = h.client.call(ctx, "POST", "contract_openorders", payload, true, true, orders); err != nil {
+		return nil, err
 	}
-
-	err = json.Unmarshal(r, &orders)
 	return
 }
 
 // HistoryOrders get history orders by given filters
 func (h *Hbdm) HistoryOrders(symbol string, tradeType, orderType, status, create int, pageIndex, pageSize *int) (orders *OrdersResponse, err error) {
-	payload := make(map[string]interface{}, 7)
-	payload["symbol"] = symbol
-	payload["trade_type"] = tradeType
-	payload["type"] = orderType
-	payload["status"] = status
-	payload["create_date"] = create
+	return h.HistoryOrdersCtx(context.Background(), symbol, tradeType, orderType, status, create, pageIndex, pageSize)
+}
+
+// HistoryOrdersCtx is HistoryOrders with context for request cancellation and deadlines
+func (h *Hbdm) HistoryOrdersCtx(ctx context.Context, symbol string, tradeType, orderType, status, create int, pageIndex, pageSize *int) (orders *OrdersResponse, err error) {
+	payload := make(Params, 7)
+	payload.Set("symbol", symbol)
+	payload.Set("trade_type", tradeType)
+	payload.Set("type", orderType)
+	payload.Set("status", status)
+	payload.Set("create_date", create)
 
 	if pageIndex != nil {
-		payload["page_index"] = *pageIndex
+		payload.Set("page_index", *pageIndex)
 	}
 	if pageSize != nil {
-		payload["page_size"] = *pageSize
+		payload.Set("page_size", *pageSize)
 	}
 
-	r, err := h.client.do("POST", "contract_hisorders", payload, true)
-	if err != nil {
-		return
+	orders = new(OrdersResponse)
+	if err = h.client.call(ctx, "POST", "contract_hisorders", payload, true, true, orders); err != nil {
+		return nil, err
 	}
+	return
+}
 
-	var response interface{}
-	if err = json.Unmarshal(r, &response); err != nil {
-		return
-	}
+// OrderIterator iterates over orders of all pages
+type OrderIterator struct {
+	pager
+	orders []OrderInfoData
+}
 
-	if err = handleErr(response); err != nil {
-		return
+// Next advances iterator to next order, it returns false when orders are over or error occurred
+func (it *OrderIterator) Next() bool {
+	return it.next()
+}
+
+// Order returns current order
+func (it *OrderIterator) Order() OrderInfoData {
+	return it.orders[it.pos]
+}
+
+// newOrderIterator returns orders iterator over pages fetched by given method
+func newOrderIterator(ctx context.Context, opts PageOptions, fetch func(ctx context.Context, pageIndex, pageSize *int) (*OrdersResponse, error)) *OrderIterator {
+	it := &OrderIterator{}
+	it.pager = pager{
+		ctx:  ctx,
+		opts: opts,
+		fetch: func(ctx context.Context, pageIndex, pageSize *int) (int, Pagination, error) {
+			resp, err := fetch(ctx, pageIndex, pageSize)
+			if err != nil {
+				return 0, Pagination{}, err
+			}
+			it.orders = resp.Data.Orders
+			return len(it.orders), resp.Data.Pagination, nil
+		},
+	}
+	return it
+}
+
+// collectOrders returns all orders of iterator
+func collectOrders(it *OrderIterator) (orders []OrderInfoData, err error) {
+	for it.Next() {
+		orders = append(orders, it.Order())
 	}
+	return orders, it.Err()
+}
 
-	err = json.Unmarshal(r, &orders)
-	return
+// IterateOpenOrders returns iterator over open orders of all pages
+func (h *Hbdm) IterateOpenOrders(ctx context.Context, symbol string, opts PageOptions) *OrderIterator {
+	return newOrderIterator(ctx, opts, func(ctx context.Context, pageIndex, pageSize *int) (*OrdersResponse, error) {
+		return h.OpenOrdersCtx(ctx, symbol, pageIndex, pageSize)
+	})
+}
+
+// AllOpenOrders get open orders of all pages
+func (h *Hbdm) AllOpenOrders(ctx context.Context, symbol string, opts PageOptions) ([]OrderInfoData, error) {
+	return collectOrders(h.IterateOpenOrders(ctx, symbol, opts))
+}
+
+// IterateHistoryOrders returns iterator over history orders of all pages by given filters
+func (h *Hbdm) IterateHistoryOrders(ctx context.Context, symbol string, tradeType, orderType, status, create int, opts PageOptions) *OrderIterator {
+	return newOrderIterator(ctx, opts, func(ctx context.Context, pageIndex, pageSize *int) (*OrdersResponse, error) {
+		return h.HistoryOrdersCtx(ctx, symbol, tradeType, orderType, status, create, pageIndex, pageSize)
+	})
+}
+
+// AllHistoryOrders get history orders of all pages by given filters
+func (h *Hbdm) AllHistoryOrders(ctx context.Context, symbol string, tradeType, orderType, status, create int, opts PageOptions) ([]OrderInfoData, error) {
+	return collectOrders(h.IterateHistoryOrders(ctx, symbol, tradeType, orderType, status, create, opts))
 }
@@ -0,0 +1,30 @@
+package hbdmtest
+
+// DefaultResponses is canned successful responses served by new Server, keyed by request path
+var DefaultResponses = map[string]string{
+	"/api/v1/contract_contract_info": `{"status":"ok","data":[` +
+		`{"symbol":"BTC","contract_code":"BTC200925","contract_type":"quarter","contract_size":100,"price_tick":0.01,` +
+		`"delivery_date":"20200925","create_date":"20200612","contract_status":1}],"ts":1590000000000}`,
+
+	"/api/v1/contract_index": `{"status":"ok","data":[{"symbol":"BTC","index_price":9500.25,"index_ts":1590000000000}],"ts":1590000000000}`,
+
+	"/api/v1/contract_account_info": `{"status":"ok","data":[` +
+		`{"symbol":"BTC","margin_balance":1.5,"margin_position":0.1,"margin_frozen":0,"margin_available":1.4,` +
+		`"profit_real":0,"profit_unreal":0.002,"withdraw_available":1.4,"risk_rate":15.2,"liquidation_price":null}],"ts":1590000000000}`,
+
+	"/api/v1/contract_position_info": `{"status":"ok","data":[` +
+		`{"symbol":"BTC","contract_code":"BTC200925","contract_type":"quarter","volume":10,"available":10,"frozen":0,` +
+		`"cost_open":9400.5,"cost_hold":9400.5,"profit_unreal":0.002,"profit_rate":0.02,"profit":0.002,` +
+		`"position_margin":0.1,"lever_rate":10,"direction":"buy","last_price":9500.25}],"ts":1590000000000}`,
+
+	"/api/v1/contract_order": `{"status":"ok","data":{"order_id":733264528417345536,"order_id_str":"733264528417345536","client_order_id":1},"ts":1590000000000}`,
+
+	"/api/v1/contract_order_info": `{"status":"ok","data":[` +
+		`{"symbol":"BTC","contract_code":"BTC200925","contract_type":"quarter","volume":1,"price":9500,"order_price_type":"limit",` +
+		`"direction":"buy","offset":"open","lever_rate":10,"order_id":733264528417345536,"client_order_id":1,` +
+		`"created_at":1590000000000,"trade_volume":0,"trade_turnover":0,"fee":0,"trade_avg_price":null,` +
+		`"margin_frozen":0.001,"profit":0,"status":3}],"ts":1590000000000}`,
+
+	"/market/depth": `{"status":"ok","ch":"market.BTC_CQ.depth.step0","tick":{"ch":"market.BTC_CQ.depth.step0","mrid":1,"id":1590000000,` +
+		`"ts":1590000000000,"version":1,"bids":[[9500.1,10],[9500,25]],"asks":[[9500.2,5],[9501,40]]},"ts":1590000000000}`,
+}
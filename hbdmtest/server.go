@@ -0,0 +1,267 @@
+// Package hbdmtest provides mock hbdm API server for offline testing of hbdm clients and
+// applications built on them. Server verifies request signatures, serves canned REST
+// responses and pushes scripted market and order data to Websocket subscribers
+package hbdmtest
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/andskur/hbdm-go"
+)
+
+// Websocket API paths served by Server
+const (
+	MarketPath       = "/ws"
+	NotificationPath = "/notification"
+)
+
+// Request is REST API request received by Server
+type Request struct {
+	Method string
+	Path   string
+	Query  url.Values
+	Body   []byte
+	Signed bool
+}
+
+// Params decodes JSON body of POST request into given value
+func (r Request) Params(v interface{}) error {
+	return json.Unmarshal(r.Body, v)
+}
+
+// response is canned REST API response
+type response struct {
+	status  int
+	body    []byte
+	private bool
+}
+
+// privatePrefixes are path prefixes of REST API's where methods require signature
+var privatePrefixes = []string{"/api/v1/", "/swap-api/v1/", "/linear-swap-api/v1/", "/v1/"}
+
+// publicPaths are public market data methods under private prefixes
+var publicPaths = map[string]bool{
+	"/api/v1/contract_contract_info":                   true,
+	"/api/v1/contract_index":                           true,
+	"/api/v1/contract_price_limit":                     true,
+	"/api/v1/contract_open_interest":                   true,
+	"/swap-api/v1/swap_contract_info":                  true,
+	"/swap-api/v1/swap_index":                          true,
+	"/swap-api/v1/swap_price_limit":                    true,
+	"/swap-api/v1/swap_open_interest":                  true,
+	"/swap-api/v1/swap_funding_rate":                   true,
+	"/swap-api/v1/swap_historical_funding_rate":        true,
+	"/linear-swap-api/v1/swap_contract_info":           true,
+	"/linear-swap-api/v1/swap_index":                   true,
+	"/linear-swap-api/v1/swap_price_limit":             true,
+	"/linear-swap-api/v1/swap_open_interest":           true,
+	"/linear-swap-api/v1/swap_funding_rate":            true,
+	"/linear-swap-api/v1/swap_historical_funding_rate": true,
+}
+
+// IsPrivate reports whether requests to given path must be signed by default, it's every
+// method of contract, swap and spot API's except public market data methods
+func IsPrivate(path string) bool {
+	if publicPaths[path] {
+		return false
+	}
+	for _, prefix := range privatePrefixes {
+		if strings.HasPrefix(path, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// Server is mock hbdm REST and Websocket API server, it accepts requests signed by
+// given API key and secret. Unsigned or wrongly signed requests to private paths get
+// 403 error, requests to paths without canned responses get 404 error
+type Server struct {
+	*httptest.Server
+
+	apiKey    string
+	apiSecret string
+	upgrader  websocket.Upgrader
+
+	mu        sync.Mutex
+	responses map[string]response
+	requests  []Request
+	conns     map[*wsConn]struct{}
+	subscribe chan struct{} // closed and replaced on each Websocket subscription
+}
+
+// NewServer starts and returns mock server accepting given API credentials, DefaultResponses
+// are served until they are replaced by Handle or HandleJSON. Close server after use
+func NewServer(apiKey, apiSecret string) *Server {
+	s := &Server{
+		apiKey:    apiKey,
+		apiSecret: apiSecret,
+		responses: make(map[string]response, len(DefaultResponses)),
+		conns:     make(map[*wsConn]struct{}),
+		subscribe: make(chan struct{}),
+	}
+
+	for path, body := range DefaultResponses {
+		s.Handle(path, http.StatusOK, body)
+	}
+
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// Endpoints returns endpoints of server for hbdm.WithEndpoints and ws.WithEndpoints options,
+// server is used both as hbdm and Huobi spot API
+func (s *Server) Endpoints() hbdm.Endpoints {
+	wsURL := "ws" + strings.TrimPrefix(s.URL, "http")
+
+	return hbdm.Endpoints{
+		REST:     s.URL,
+		WSMarket: wsURL + MarketPath,
+		WSOrders: wsURL + NotificationPath,
+		Spot:     s.URL,
+	}
+}
+
+// Handle sets canned response of given HTTP status and body for requests to given path
+// like "/api/v1/contract_order" or "/market/depth", path is private if IsPrivate reports so
+func (s *Server) Handle(path string, status int, body string) {
+	s.handle(path, response{status: status, body: []byte(body), private: IsPrivate(path)})
+}
+
+// HandlePrivate sets canned response like Handle and requires signature of requests to given path
+func (s *Server) HandlePrivate(path string, status int, body string) {
+	s.handle(path, response{status: status, body: []byte(body), private: true})
+}
+
+// handle sets canned response of given path
+func (s *Server) handle(path string, resp response) {
+	s.mu.Lock()
+	s.responses[path] = resp
+	s.mu.Unlock()
+}
+
+// HandleJSON sets canned successful response for requests to given path like Handle, data is wrapped
+// into "ok" status envelope like {"status":"ok","data":data,"ts":...}
+func (s *Server) HandleJSON(path string, data interface{}) error {
+	body, err := json.Marshal(map[string]interface{}{
+		"status": "ok",
+		"data":   data,
+		"ts":     timestamp(),
+	})
+	if err != nil {
+		return err
+	}
+
+	s.Handle(path, http.StatusOK, string(body))
+	return nil
+}
+
+// HandleError sets canned hbdm API error response for requests to given path like Handle
+func (s *Server) HandleError(path string, code int, message string) {
+	body, _ := json.Marshal(errorBody(code, message))
+	s.Handle(path, http.StatusOK, string(body))
+}
+
+// Requests returns REST API requests received by server in order of arrival
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	requests := make([]Request, len(s.requests))
+	copy(requests, s.requests)
+	return requests
+}
+
+// serveHTTP routes Websocket and REST API requests
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.URL.Path {
+	case MarketPath:
+		s.serveWS(w, r, false)
+		return
+	case NotificationPath:
+		s.serveWS(w, r, true)
+		return
+	}
+
+	body, _ := ioutil.ReadAll(r.Body)
+	query := r.URL.Query()
+	signed := query.Get("AccessKeyId") != ""
+
+	s.mu.Lock()
+	s.requests = append(s.requests, Request{
+		Method: r.Method,
+		Path:   r.URL.Path,
+		Query:  query,
+		Body:   body,
+		Signed: signed,
+	})
+	resp, ok := s.responses[r.URL.Path]
+	s.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+
+	if signed && !s.verify(query, r.Method, r.Host, r.URL.Path) {
+		writeJSON(w, http.StatusOK, errorBody(403, "Incorrect signature"))
+		return
+	}
+
+	if !signed && (resp.private || !ok && IsPrivate(r.URL.Path)) {
+		writeJSON(w, http.StatusOK, errorBody(403, "Missing signature"))
+		return
+	}
+
+	if !ok {
+		writeJSON(w, http.StatusNotFound, errorBody(404, "Not found"))
+		return
+	}
+
+	w.WriteHeader(resp.status)
+	w.Write(resp.body)
+}
+
+// verify checks access key and signature of signed request with given parameters
+func (s *Server) verify(query url.Values, method, host, path string) bool {
+	if query.Get("AccessKeyId") != s.apiKey {
+		return false
+	}
+
+	// every query parameter except signature itself is signed
+	params := make(map[string]string, len(query))
+	for key := range query {
+		if key != "Signature" {
+			params[key] = query.Get(key)
+		}
+	}
+
+	return query.Get("Signature") == hbdm.CreateSign(params, method, host, path, s.apiSecret)
+}
+
+// errorBody returns hbdm API error response body
+func errorBody(code int, message string) map[string]interface{} {
+	return map[string]interface{}{
+		"status":   "error",
+		"err_code": code,
+		"err_msg":  message,
+		"ts":       timestamp(),
+	}
+}
+
+// writeJSON writes response with given HTTP status and JSON body
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(body)
+}
+
+// timestamp returns current time in milliseconds like API timestamps
+func timestamp() int64 {
+	return time.Now().UnixNano() / int64(time.Millisecond)
+}
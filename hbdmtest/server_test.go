@@ -0,0 +1,208 @@
+package hbdmtest_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/andskur/hbdm-go"
+	"github.com/andskur/hbdm-go/hbdmtest"
+	"github.com/andskur/hbdm-go/ws"
+)
+
+func newClient(srv *hbdmtest.Server, apiSecret string) *hbdm.Hbdm {
+	return hbdm.New("key", apiSecret,
+		hbdm.WithEndpoints(srv.Endpoints()),
+		hbdm.WithNonceStore(hbdm.NewMemoryNonceStore(0)),
+	)
+}
+
+func TestSignedRequest(t *testing.T) {
+	srv := hbdmtest.NewServer("key", "secret")
+	defer srv.Close()
+
+	info, err := newClient(srv, "secret").AccountInfo("BTC")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(info.Data) != 1 || info.Data[0].Symbol != "BTC" {
+		t.Errorf("unexpected account info %+v", info.Data)
+	}
+
+	requests := srv.Requests()
+	if len(requests) != 1 || !requests[0].Signed {
+		t.Errorf("got requests %+v, want one signed", requests)
+	}
+}
+
+func TestWrongSignature(t *testing.T) {
+	srv := hbdmtest.NewServer("key", "secret")
+	defer srv.Close()
+
+	_, err := newClient(srv, "wrong").AccountInfo("BTC")
+	if !errors.Is(err, hbdm.ErrAuth) {
+		t.Errorf("got error %v, want %v", err, hbdm.ErrAuth)
+	}
+}
+
+func TestSignedQueryParams(t *testing.T) {
+	srv := hbdmtest.NewServer("key", "secret")
+	defer srv.Close()
+	srv.HandlePrivate("/market/private", http.StatusOK, `{"status":"ok","ts":1}`)
+
+	u, err := url.Parse(srv.URL + "/market/private")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	params := map[string]string{
+		"AccessKeyId":      "key",
+		"SignatureMethod":  "HmacSHA256",
+		"SignatureVersion": "2",
+		"Timestamp":        time.Now().UTC().Format("2006-01-02T15:04:05"),
+		"symbol":           "BTC",
+	}
+	signature := hbdm.CreateSign(params, "GET", u.Host, u.Path, "secret")
+
+	for _, tt := range []struct {
+		symbol string
+		status string
+	}{
+		{"BTC", "ok"},
+		{"ETH", "error"}, // query parameters are signed too
+	} {
+		query := url.Values{}
+		for key, value := range params {
+			query.Set(key, value)
+		}
+		query.Set("symbol", tt.symbol)
+		query.Set("Signature", signature)
+		u.RawQuery = query.Encode()
+
+		resp, err := http.Get(u.String())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var body hbdm.Response
+		err = json.NewDecoder(resp.Body).Decode(&body)
+		resp.Body.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if body.Status != tt.status {
+			t.Errorf("symbol %s: got status %q, want %q", tt.symbol, body.Status, tt.status)
+		}
+	}
+}
+
+func TestUnsignedPrivateRequest(t *testing.T) {
+	srv := hbdmtest.NewServer("key", "secret")
+	defer srv.Close()
+	srv.HandlePrivate("/market/private", http.StatusOK, `{"status":"ok","ts":1}`)
+
+	h := newClient(srv, "secret")
+	for _, path := range []string{"/api/v1/contract_order", "/api/v1/contract_unknown", "/market/private"} {
+		var resp hbdm.Response
+		err := h.Call(context.Background(), "POST", path, hbdm.Params{}, false, false, &resp)
+		if !errors.Is(err, hbdm.ErrAuth) {
+			t.Errorf("%s: got error %v, want %v", path, err, hbdm.ErrAuth)
+		}
+	}
+
+	// public market data doesn't need signature
+	if _, err := h.ContractIndex("BTC"); err != nil {
+		t.Errorf("public request: %v", err)
+	}
+}
+
+func TestPushMarket(t *testing.T) {
+	srv := hbdmtest.NewServer("key", "secret")
+	defer srv.Close()
+
+	client, err := ws.NewWSMarketClient(ws.WithEndpoints(srv.Endpoints()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	depth, err := client.SubscribeMarketDepth("BTC_CQ")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	errs := make(chan error, 1)
+	go func() {
+		errs <- srv.PushMarket("market.BTC_CQ.depth.step0", map[string]interface{}{
+			"bids": [][]float64{{9500.1, 10}},
+			"asks": [][]float64{{9500.2, 5}},
+		})
+	}()
+
+	select {
+	case resp := <-depth:
+		if len(resp.Tick.Bids) != 1 || resp.Tick.Bids[0].Price.String() != "9500.1" || resp.Tick.Asks[0].Amount.String() != "5" {
+			t.Errorf("unexpected depth %+v", resp.Tick)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("depth push timeout")
+	}
+
+	if err := <-errs; err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPushOrder(t *testing.T) {
+	srv := hbdmtest.NewServer("key", "secret")
+	defer srv.Close()
+
+	client, err := ws.NewWSTradeClient("key", "secret", ws.WithEndpoints(srv.Endpoints()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	orders, err := client.SubscribeOrderPush("BTC")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	errs := make(chan error, 1)
+	go func() {
+		errs <- srv.PushOrder("orders.BTC", map[string]interface{}{
+			"symbol":          "BTC",
+			"order_id":        int64(733264528417345536),
+			"client_order_id": 7,
+			"price":           9500.5,
+		})
+	}()
+
+	select {
+	case resp := <-orders:
+		if resp.OrderId != 733264528417345536 || resp.ClientOrderId != 7 || resp.Price.String() != "9500.5" {
+			t.Errorf("unexpected order %+v", resp)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("order push timeout")
+	}
+
+	if err := <-errs; err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPushWithoutSubscriber(t *testing.T) {
+	srv := hbdmtest.NewServer("key", "secret")
+	defer srv.Close()
+
+	timeout := hbdmtest.SubscriptionTimeout
+	hbdmtest.SubscriptionTimeout = 10 * time.Millisecond
+	defer func() { hbdmtest.SubscriptionTimeout = timeout }()
+
+	if err := srv.PushMarket("market.BTC_CQ.depth.step0", nil); err == nil {
+		t.Error("got nil error without subscribers")
+	}
+}
@@ -0,0 +1,296 @@
+package hbdmtest
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// SubscriptionTimeout is how long Push methods wait for subscription to given topic
+var SubscriptionTimeout = 5 * time.Second
+
+// wsConn is Websocket connection of client to mock server
+type wsConn struct {
+	conn          *websocket.Conn
+	mu            sync.Mutex
+	notification  bool
+	authenticated bool
+	topics        map[string]bool
+}
+
+// write sends gzip compressed JSON message like hbdm Websocket API does
+func (c *wsConn) write(v interface{}) error {
+	msg, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if _, err := zw.Write(msg); err != nil {
+		return err
+	}
+	if err := zw.Close(); err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.conn.WriteMessage(websocket.BinaryMessage, buf.Bytes())
+}
+
+// wsRequest is client message to market or notification Websocket API
+type wsRequest struct {
+	// market API
+	Sub string `json:"sub"`
+	Id  string `json:"id"`
+
+	// notification API
+	Op               string `json:"op"`
+	Cid              string `json:"cid"`
+	Topic            string `json:"topic"`
+	AccessKeyId      string `json:"AccessKeyId"`
+	SignatureMethod  string `json:"SignatureMethod"`
+	SignatureVersion string `json:"SignatureVersion"`
+	Timestamp        string `json:"Timestamp"`
+	Signature        string `json:"Signature"`
+}
+
+// serveWS upgrades request to Websocket connection and serves client messages until it's closed
+func (s *Server) serveWS(w http.ResponseWriter, r *http.Request, notification bool) {
+	conn, err := s.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return
+	}
+
+	c := &wsConn{conn: conn, notification: notification, topics: make(map[string]bool)}
+
+	s.mu.Lock()
+	s.conns[c] = struct{}{}
+	s.mu.Unlock()
+
+	defer func() {
+		s.mu.Lock()
+		delete(s.conns, c)
+		s.mu.Unlock()
+		conn.Close()
+	}()
+
+	for {
+		_, msg, err := conn.ReadMessage()
+		if err != nil {
+			return
+		}
+
+		var req wsRequest
+		if err := json.Unmarshal(msg, &req); err != nil {
+			continue
+		}
+
+		if notification {
+			s.handleNotification(c, req, r.Host)
+		} else {
+			s.handleMarket(c, req)
+		}
+	}
+}
+
+// handleMarket process market Websocket API subscription
+func (s *Server) handleMarket(c *wsConn, req wsRequest) {
+	if req.Sub == "" {
+		return
+	}
+
+	s.subscribed(c, req.Sub)
+
+	c.write(map[string]interface{}{
+		"id":     req.Id,
+		"status": "ok",
+		"subbed": req.Sub,
+		"ts":     timestamp(),
+	})
+}
+
+// handleNotification process notification Websocket API authentication and subscription
+func (s *Server) handleNotification(c *wsConn, req wsRequest, host string) {
+	switch req.Op {
+	case "auth":
+		query := url.Values{}
+		query.Set("AccessKeyId", req.AccessKeyId)
+		query.Set("SignatureMethod", req.SignatureMethod)
+		query.Set("SignatureVersion", req.SignatureVersion)
+		query.Set("Timestamp", req.Timestamp)
+		query.Set("Signature", req.Signature)
+
+		code := 0
+		if s.verify(query, "GET", host, NotificationPath) {
+			s.mu.Lock()
+			c.authenticated = true
+			s.mu.Unlock()
+		} else {
+			code = 2002
+		}
+
+		c.write(map[string]interface{}{
+			"op":       "auth",
+			"type":     "api",
+			"err-code": code,
+			"ts":       timestamp(),
+		})
+	case "sub":
+		s.mu.Lock()
+		authenticated := c.authenticated
+		s.mu.Unlock()
+
+		code := 0
+		if authenticated {
+			s.subscribed(c, req.Topic)
+		} else {
+			code = 2002
+		}
+
+		c.write(map[string]interface{}{
+			"op":       "sub",
+			"cid":      req.Cid,
+			"topic":    req.Topic,
+			"err-code": code,
+			"ts":       timestamp(),
+		})
+	}
+}
+
+// subscribed registers subscription of connection and wakes up waiting Push methods
+func (s *Server) subscribed(c *wsConn, topic string) {
+	s.mu.Lock()
+	c.topics[topic] = true
+	close(s.subscribe)
+	s.subscribe = make(chan struct{})
+	s.mu.Unlock()
+}
+
+// subscribers returns connections subscribed to given topic, it waits for
+// subscription up to SubscriptionTimeout
+func (s *Server) subscribers(topic string, notification bool) ([]*wsConn, error) {
+	timeout := time.NewTimer(SubscriptionTimeout)
+	defer timeout.Stop()
+
+	for {
+		var conns []*wsConn
+
+		s.mu.Lock()
+		for c := range s.conns {
+			if c.notification == notification && c.topics[topic] {
+				conns = append(conns, c)
+			}
+		}
+		subscribe := s.subscribe
+		s.mu.Unlock()
+
+		if len(conns) > 0 {
+			return conns, nil
+		}
+
+		select {
+		case <-subscribe:
+		case <-timeout.C:
+			return nil, fmt.Errorf("hbdmtest: no subscribers of %s", topic)
+		}
+	}
+}
+
+// PushMarket sends market data message with given channel and tick like
+// {"ch":"market.BTC_CQ.depth.step0","ts":...,"tick":tick} to its subscribers.
+// It waits for subscription, push data after client Subscribe method returned
+func (s *Server) PushMarket(ch string, tick interface{}) error {
+	conns, err := s.subscribers(ch, false)
+	if err != nil {
+		return err
+	}
+
+	msg := map[string]interface{}{
+		"ch":   ch,
+		"ts":   timestamp(),
+		"tick": tick,
+	}
+
+	for _, c := range conns {
+		if err := c.write(msg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PushOrder sends order notification with given topic like "orders.BTC" to its subscribers,
+// order fields are merged into {"op":"notify","topic":topic,"ts":...} message.
+// It waits for subscription, push data after client Subscribe method returned
+func (s *Server) PushOrder(topic string, order interface{}) error {
+	conns, err := s.subscribers(topic, true)
+	if err != nil {
+		return err
+	}
+
+	b, err := json.Marshal(order)
+	if err != nil {
+		return err
+	}
+
+	// numbers are kept as is to not lose precision of order ids
+	msg := make(map[string]interface{})
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	if err := dec.Decode(&msg); err != nil {
+		return fmt.Errorf("hbdmtest: order must be JSON object: %v", err)
+	}
+	msg["op"] = "notify"
+	msg["topic"] = topic
+	msg["ts"] = timestamp()
+
+	for _, c := range conns {
+		if err := c.write(msg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Ping sends ping message to all Websocket connections, clients are expected to reply with pong
+func (s *Server) Ping() error {
+	s.mu.Lock()
+	conns := make([]*wsConn, 0, len(s.conns))
+	for c := range s.conns {
+		conns = append(conns, c)
+	}
+	s.mu.Unlock()
+
+	ts := timestamp()
+	for _, c := range conns {
+		var msg interface{} = map[string]interface{}{"ping": ts}
+		if c.notification {
+			msg = map[string]interface{}{"op": "ping", "ts": strconv.FormatInt(ts, 10)}
+		}
+		if err := c.write(msg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Close closes Websocket connections and shuts down server
+func (s *Server) Close() {
+	s.mu.Lock()
+	for c := range s.conns {
+		c.conn.Close()
+	}
+	s.mu.Unlock()
+
+	s.Server.Close()
+}
@@ -2,14 +2,13 @@ package hbdm
 
 import (
 	"bytes"
+	"context"
 	"crypto/hmac"
 	"crypto/sha256"
 	"encoding/base64"
 	"encoding/json"
-	"errors"
 	"fmt"
 	"io/ioutil"
-	"log"
 	"net/http"
 	"net/http/httputil"
 	"net/url"
@@ -18,113 +17,206 @@ import (
 	"time"
 )
 
-const hostName = "api.hbdm.com"
+// apiPath is path prefix of hbdm contract API resources
+const apiPath = "/api/v1/"
 
 type client struct {
 	apiKey      string
 	apiSecret   string
+	endpoints   Endpoints
 	httpClient  *http.Client
 	httpTimeout time.Duration
 	debug       bool
+	logger      Logger
+	limiter     *RateLimiter
+	retry       RetryPolicy
 }
 
-// NewHttpClient return a new HitBtc HTTP client
+// NewHttpClient return a new hbdm HTTP client
 func NewHttpClient(apiKey, apiSecret string) (c *client) {
-	return &client{apiKey, apiSecret, &http.Client{}, 30 * time.Second, false}
+	return &client{
+		apiKey:      apiKey,
+		apiSecret:   apiSecret,
+		endpoints:   DefaultEndpoints,
+		httpClient:  &http.Client{},
+		httpTimeout: 30 * time.Second,
+		logger:      NewStdLogger(nil),
+		limiter:     NewRateLimiter(DefaultRateLimits),
+		retry:       DefaultRetryPolicy,
+	}
 }
 
-// NewHttpClientWithCustomHttpConfig returns a new HitBtc HTTP client using the predefined http client
+// NewHttpClientWithCustomHttpConfig returns a new hbdm HTTP client using the predefined http client
 func NewHttpClientWithCustomHttpConfig(apiKey, apiSecret string, httpClient *http.Client) (c *client) {
-	timeout := httpClient.Timeout
-	if timeout <= 0 {
-		timeout = 30 * time.Second
+	c = NewHttpClient(apiKey, apiSecret)
+	c.httpClient = httpClient
+	if httpClient.Timeout > 0 {
+		c.httpTimeout = httpClient.Timeout
 	}
-	return &client{apiKey, apiSecret, httpClient, timeout, false}
+	return c
 }
 
-// NewHttpClient returns a new HitBtc HTTP client with custom timeout
+// NewHttpClientWithCustomTimeout returns a new hbdm HTTP client with custom timeout
 func NewHttpClientWithCustomTimeout(apiKey, apiSecret string, timeout time.Duration) (c *client) {
-	return &client{apiKey, apiSecret, &http.Client{}, timeout, false}
+	c = NewHttpClient(apiKey, apiSecret)
+	c.httpTimeout = timeout
+	return c
 }
 
+// dumpRequest logs request dump with redacted credentials
 func (c client) dumpRequest(r *http.Request) {
 	if r == nil {
-		log.Print("dumpReq ok: <nil>")
+		c.logger.Debug("hbdm request", "dump", "<nil>")
 		return
 	}
 	dump, err := httputil.DumpRequest(r, true)
 	if err != nil {
-		log.Print("dumpReq err:", err)
-	} else {
-		log.Print("dumpReq ok:", string(dump))
+		c.logger.Error("hbdm request dump", "err", err)
+		return
 	}
+	c.logger.Debug("hbdm request", "dump", Redact(string(dump)))
 }
 
+// dumpResponse logs response dump
 func (c client) dumpResponse(r *http.Response) {
 	if r == nil {
-		log.Print("dumpResponse ok: <nil>")
+		c.logger.Debug("hbdm response", "dump", "<nil>")
 		return
 	}
 	dump, err := httputil.DumpResponse(r, true)
 	if err != nil {
-		log.Print("dumpResponse err:", err)
-	} else {
-		log.Print("dumpResponse ok:", string(dump))
+		c.logger.Error("hbdm response dump", "err", err)
+		return
 	}
+	c.logger.Debug("hbdm response", "dump", string(dump))
 }
 
-// doTimeoutRequest do a HTTP request with timeout
-func (c *client) doTimeoutRequest(timer *time.Timer, req *http.Request) (*http.Response, error) {
-	// Do the request in the background so we can check the timeout
-	type result struct {
-		resp *http.Response
-		err  error
+// doRequest do a HTTP request with debug dumps
+func (c *client) doRequest(req *http.Request) (*http.Response, error) {
+	if c.debug {
+		c.dumpRequest(req)
+	}
+	resp, err := c.httpClient.Do(req)
+	if c.debug {
+		c.dumpResponse(resp)
 	}
-	done := make(chan result, 1)
-	go func() {
-		if c.debug {
-			c.dumpRequest(req)
+	return resp, err
+}
+
+// resourceURL returns full url of given API resource, resource may be a full url,
+// a path from REST API root or a contract API method name
+func (c *client) resourceURL(resource string) string {
+	switch {
+	case strings.HasPrefix(resource, "http"):
+		return resource
+	case strings.HasPrefix(resource, "/"):
+		return c.endpoints.REST + resource
+	default:
+		return c.endpoints.REST + apiPath + resource
+	}
+}
+
+// signHost returns host used in signature of request to given url
+func (c *client) signHost(u *url.URL) string {
+	switch u.Host {
+	case signHost(c.endpoints.REST, ""):
+		return signHost(c.endpoints.REST, c.endpoints.SignHost)
+	case signHost(c.endpoints.Spot, ""):
+		return signHost(c.endpoints.Spot, c.endpoints.SpotSignHost)
+	}
+	return u.Host
+}
+
+// spotURL returns full url of Huobi spot API path
+func (c *client) spotURL(path string) string {
+	return c.endpoints.Spot + path
+}
+
+// call process request to hbdm API and decodes response body into given typed result,
+// idempotent requests are retried on transient failures by client retry policy
+func (c *client) call(ctx context.Context, method string, resource string, payload Params, authNeeded, idempotent bool, result Enveloper) error {
+	for attempt := 1; ; attempt++ {
+		err := c.callOnce(ctx, method, resource, payload, authNeeded, result)
+		if !idempotent || !c.retry.retry(ctx, attempt, err) {
+			return err
 		}
-		resp, err := c.httpClient.Do(req)
-		if c.debug {
-			c.dumpResponse(resp)
+
+		c.logger.Warn("hbdm request retry", "endpoint", resource, "attempt", attempt, "err", err)
+		if err := c.retry.wait(ctx, attempt); err != nil {
+			return err
 		}
-		done <- result{resp, err}
-	}()
-	// Wait for the read or the timeout
-	select {
-	case r := <-done:
-		return r.resp, r.err
-	case <-timer.C:
-		return nil, errors.New("timeout on reading data from HitBtc API")
+		resetResult(result)
 	}
 }
 
+// callOnce process single request to hbdm API and decodes response body once into given typed result
+func (c *client) callOnce(ctx context.Context, method string, resource string, payload Params, authNeeded bool, result Enveloper) error {
+	body, httpStatus, err := c.do(ctx, method, resource, payload, authNeeded)
+	if err != nil {
+		return err
+	}
+
+	if httpStatus != http.StatusOK {
+		return handleErr(resource, httpStatus, body)
+	}
+
+	if err := json.Unmarshal(body, result); err != nil {
+		// error responses data may not fit typed result
+		if apiErr := handleErr(resource, httpStatus, body); apiErr != nil {
+			return apiErr
+		}
+		return fmt.Errorf("hbdm %s: unmarshalling response: %v", resource, err)
+	}
+
+	if envelope := result.Envelope(); envelope.Status == "error" || envelope.ErrCode != 0 {
+		return handleErr(resource, httpStatus, body)
+	}
+
+	return nil
+}
+
 // do prepare and process HTTP request to hdbm API
-func (c *client) do(method string, resource string, payload map[string]interface{}, authNeeded bool) (response []byte, err error) {
-	connectTimer := time.NewTimer(c.httpTimeout)
+func (c *client) do(ctx context.Context, method string, resource string, payload Params, authNeeded bool) (response []byte, httpStatus int, err error) {
+	if c.httpTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.httpTimeout)
+		defer cancel()
+	}
+
+	rawurl := c.resourceURL(resource)
+	query := payload.Query()
+
+	api, class := RateLimitAPI(rawurl), rateLimitClass(resource, authNeeded)
+	if c.limiter != nil {
+		if err = c.limiter.Wait(ctx, api, class); err != nil {
+			return
+		}
+	}
 
 	if authNeeded {
+		var URL *url.URL
+		URL, err = url.Parse(rawurl)
+		if err != nil {
+			return
+		}
+
 		timestamp := time.Now().UTC().Format("2006-01-02T15:04:05")
-		strRequest := "/api/v1/" + resource
 
 		mapParams2Sign := make(map[string]string)
+		// GET request parameters are sent in query string, so they are signed too
+		if method == "GET" {
+			for key := range query {
+				mapParams2Sign[key] = query.Get(key)
+			}
+		}
 		mapParams2Sign["AccessKeyId"] = c.apiKey
 		mapParams2Sign["SignatureMethod"] = "HmacSHA256"
 		mapParams2Sign["SignatureVersion"] = "2"
 		mapParams2Sign["Timestamp"] = timestamp
-		hostName := hostName
 
-		mapParams2Sign["Signature"] = CreateSign(mapParams2Sign, method, hostName, strRequest, c.apiSecret)
+		mapParams2Sign["Signature"] = CreateSign(mapParams2Sign, method, c.signHost(URL), URL.Path, c.apiSecret)
 
-		resource = resource + "?" + Map2UrlQuery(MapValueEncodeURI(mapParams2Sign))
-	}
-
-	var rawurl string
-	if strings.HasPrefix(resource, "http") {
-		rawurl = resource
-	} else {
-		rawurl = fmt.Sprintf("%s/%s", API_BASE, resource)
+		rawurl = rawurl + "?" + Map2UrlQuery(MapValueEncodeURI(mapParams2Sign))
 	}
 
 	var req *http.Request
@@ -135,26 +227,24 @@ func (c *client) do(method string, resource string, payload map[string]interface
 			return
 		}
 		q := URL.Query()
-		for key, value := range payload {
-			q.Set(key, value.(string))
+		for key, values := range query {
+			q[key] = values
 		}
-		formData := q.Encode()
-		URL.RawQuery = formData
-		rawurl = URL.String()
+		URL.RawQuery = q.Encode()
 
-		req, err = http.NewRequest(method, rawurl, strings.NewReader(formData))
+		req, err = http.NewRequestWithContext(ctx, method, URL.String(), nil)
 		if err != nil {
 			return
 		}
 	} else {
 		body, err := json.Marshal(payload)
 		if err != nil {
-			return nil, err
+			return nil, 0, err
 		}
 
-		req, err = http.NewRequest(method, rawurl, bytes.NewBuffer(body))
+		req, err = http.NewRequestWithContext(ctx, method, rawurl, bytes.NewBuffer(body))
 		if err != nil {
-			return nil, err
+			return nil, 0, err
 		}
 
 		req.Header.Set("Content-Type", "application/json")
@@ -162,20 +252,19 @@ func (c *client) do(method string, resource string, payload map[string]interface
 
 	req.Header.Add("Accept", "application/json")
 
-	resp, err := c.doTimeoutRequest(connectTimer, req)
+	resp, err := c.doRequest(req)
 	if err != nil {
 		return
 	}
 
 	defer resp.Body.Close()
-	response, err = ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return response, err
-	}
-	if resp.StatusCode != 200 && resp.StatusCode != 401 {
-		err = errors.New(resp.Status)
+
+	if c.limiter != nil {
+		c.limiter.update(api, class, resp.Header)
 	}
-	return response, err
+
+	response, err = ioutil.ReadAll(resp.Body)
+	return response, resp.StatusCode, err
 }
 
 // 对Map的值进行URI编码
@@ -0,0 +1,70 @@
+package hbdm
+
+import (
+	"context"
+	"fmt"
+)
+
+// Lightning close order price types
+const (
+	PriceTypeLightning    OrderPriceType = "lightning"
+	PriceTypeLightningIOC OrderPriceType = "lightning_ioc"
+	PriceTypeLightningFOK OrderPriceType = "lightning_fok"
+)
+
+// LightningCloseResponse is response from LightningClose method
+type LightningCloseResponse struct {
+	Response
+	Data struct {
+		OrderId       int64  `json:"order_id"`
+		OrderIdStr    string `json:"order_id_str"`
+		ClientOrderId int64  `json:"client_order_id"`
+	} `json:"data"`
+}
+
+// LightningClose close position instantly at best available price, contract is set by symbol
+// with contractType or by contractCode, direction is direction of closing order, orderPriceType
+// is one of lightning price types, lightning by default
+func (h *Hbdm) LightningClose(symbol string, contractType ContractType, contractCode string, volume int, direction Direction, orderPriceType OrderPriceType) (resp *LightningCloseResponse, err error) {
+	return h.LightningCloseCtx(context.Background(), symbol, contractType, contractCode, volume, direction, orderPriceType)
+}
+
+// LightningCloseCtx is LightningClose with context for request cancellation and deadlines
+func (h *Hbdm) LightningCloseCtx(ctx context.Context, symbol string, contractType ContractType, contractCode string, volume int, direction Direction, orderPriceType OrderPriceType) (resp *LightningCloseResponse, err error) {
+	if err = validateContract(symbol, contractType, contractCode); err != nil {
+		return
+	}
+
+	if !direction.Valid() {
+		return nil, fmt.Errorf("%w: direction %q", ErrInvalidOrder, direction)
+	}
+
+	if volume <= 0 {
+		return nil, fmt.Errorf("%w: volume %d", ErrInvalidOrder, volume)
+	}
+
+	switch orderPriceType {
+	case "", PriceTypeLightning, PriceTypeLightningIOC, PriceTypeLightningFOK:
+	default:
+		return nil, fmt.Errorf("%w: order price type %q for lightning close", ErrInvalidOrder, orderPriceType)
+	}
+
+	clientOrderId, err := h.GetAndIncrementNonce()
+	if err != nil {
+		return
+	}
+
+	payload := contractFilterPayload(symbol, string(contractType), contractCode)
+	payload.Set("volume", volume)
+	payload.Set("direction", string(direction))
+	payload.Set("client_order_id", clientOrderId)
+	if orderPriceType != "" {
+		payload.Set("order_price_type", string(orderPriceType))
+	}
+
+	resp = new(LightningCloseResponse)
+	if err = h.client.call(ctx, "POST", "lightning_close_position", payload, true, false, resp); err != nil {
+		return nil, err
+	}
+	return
+}
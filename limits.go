@@ -0,0 +1,236 @@
+package hbdm
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+// AvailableLevelRateResponse is response from AvailableLevelRate method
+type AvailableLevelRateResponse struct {
+	Response
+	Data []AvailableLevelRateData `json:"data"`
+}
+
+// AvailableLevelRateData is available leverages of symbol
+type AvailableLevelRateData struct {
+	Symbol             string `json:"symbol"`
+	AvailableLevelRate string `json:"available_level_rate"` // comma separated leverages like "1,5,10,20"
+}
+
+// LevelRates returns available leverages as numbers
+func (d AvailableLevelRateData) LevelRates() (rates []int, err error) {
+	for _, s := range strings.Split(d.AvailableLevelRate, ",") {
+		if s = strings.TrimSpace(s); s == "" {
+			continue
+		}
+
+		rate, err := strconv.Atoi(s)
+		if err != nil {
+			return nil, err
+		}
+		rates = append(rates, rate)
+	}
+	return
+}
+
+// AvailableLevelRate get leverages available to user, symbol is optional
+func (h *Hbdm) AvailableLevelRate(symbol string) (rates *AvailableLevelRateResponse, err error) {
+	return h.AvailableLevelRateCtx(context.Background(), symbol)
+}
+
+// AvailableLevelRateCtx is AvailableLevelRate with context for request cancellation and deadlines
+func (h *Hbdm) AvailableLevelRateCtx(ctx context.Context, symbol string) (rates *AvailableLevelRateResponse, err error) {
+	payload := make(Params, 1)
+	if symbol != "" {
+		payload.Set("symbol", symbol)
+	}
+
+	rates = new(AvailableLevelRateResponse)
+	if err = h.client.call(ctx, "POST", "contract_available_level_rate", payload, true, true, rates); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// PositionLimitResponse is response from PositionLimit method
+type PositionLimitResponse struct {
+	Response
+	Data []PositionLimitData `json:"data"`
+}
+
+// PositionLimitData is position limits of symbol contracts
+type PositionLimitData struct {
+	Symbol string `json:"symbol"`
+	List   []struct {
+		ContractType string          `json:"contract_type"`
+		BuyLimit     decimal.Decimal `json:"buy_limit"`
+		SellLimit    decimal.Decimal `json:"sell_limit"`
+	} `json:"list"`
+}
+
+// PositionLimit get user position limits in contracts, symbol is optional
+func (h *Hbdm) PositionLimit(symbol string) (limits *PositionLimitResponse, err error) {
+	return h.PositionLimitCtx(context.Background(), symbol)
+}
+
+// PositionLimitCtx is PositionLimit with context for request cancellation and deadlines
+func (h *Hbdm) PositionLimitCtx(ctx context.Context, symbol string) (limits *PositionLimitResponse, err error) {
+	payload := make(Params, 1)
+	if symbol != "" {
+		payload.Set("symbol", symbol)
+	}
+
+	limits = new(PositionLimitResponse)
+	if err = h.client.call(ctx, "POST", "contract_position_limit", payload, true, true, limits); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// FeeResponse is response from Fee method
+type FeeResponse struct {
+	Response
+	Data []FeeData `json:"data"`
+}
+
+// FeeData is trading fee rates of symbol
+type FeeData struct {
+	Symbol        string          `json:"symbol"`
+	FeeAsset      string          `json:"fee_asset"`
+	OpenMakerFee  decimal.Decimal `json:"open_maker_fee"`
+	OpenTakerFee  decimal.Decimal `json:"open_taker_fee"`
+	CloseMakerFee decimal.Decimal `json:"close_maker_fee"`
+	CloseTakerFee decimal.Decimal `json:"close_taker_fee"`
+	DeliveryFee   decimal.Decimal `json:"delivery_fee"`
+}
+
+// Fee get user trading fee rates, symbol is optional
+func (h *Hbdm) Fee(symbol string) (fees *FeeResponse, err error) {
+	return h.FeeCtx(context.Background(), symbol)
+}
+
+// FeeCtx is Fee with context for request cancellation and deadlines
+func (h *Hbdm) FeeCtx(ctx context.Context, symbol string) (fees *FeeResponse, err error) {
+	payload := make(Params, 1)
+	if symbol != "" {
+		payload.Set("symbol", symbol)
+	}
+
+	fees = new(FeeResponse)
+	if err = h.client.call(ctx, "POST", "contract_fee", payload, true, true, fees); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// OrderLimitResponse is response from OrderLimit method
+type OrderLimitResponse struct {
+	Response
+	Data struct {
+		OrderPriceType string           `json:"order_price_type"`
+		List           []OrderLimitData `json:"list"`
+	} `json:"data"`
+}
+
+// OrderLimitData is order volume limits of symbol contracts
+type OrderLimitData struct {
+	Symbol string `json:"symbol"`
+	Types  []struct {
+		ContractType string          `json:"contract_type"`
+		OpenLimit    decimal.Decimal `json:"open_limit"`
+		CloseLimit   decimal.Decimal `json:"close_limit"`
+	} `json:"types"`
+}
+
+// OrderLimit get maximum order volume in contracts for given order price type, symbol is optional
+func (h *Hbdm) OrderLimit(symbol string, orderPriceType OrderPriceType) (limits *OrderLimitResponse, err error) {
+	return h.OrderLimitCtx(context.Background(), symbol, orderPriceType)
+}
+
+// OrderLimitCtx is OrderLimit with context for request cancellation and deadlines
+func (h *Hbdm) OrderLimitCtx(ctx context.Context, symbol string, orderPriceType OrderPriceType) (limits *OrderLimitResponse, err error) {
+	payload := make(Params, 2)
+	payload.Set("order_price_type", string(orderPriceType))
+	if symbol != "" {
+		payload.Set("symbol", symbol)
+	}
+
+	limits = new(OrderLimitResponse)
+	if err = h.client.call(ctx, "POST", "contract_order_limit", payload, true, true, limits); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// TransferLimitResponse is response from TransferLimit method
+type TransferLimitResponse struct {
+	Response
+	Data []TransferLimitData `json:"data"`
+}
+
+// TransferLimitData is transfer limits of symbol between spot and futures accounts
+type TransferLimitData struct {
+	Symbol                 string          `json:"symbol"`
+	TransferInMaxEach      decimal.Decimal `json:"transfer_in_max_each"`
+	TransferInMinEach      decimal.Decimal `json:"transfer_in_min_each"`
+	TransferOutMaxEach     decimal.Decimal `json:"transfer_out_max_each"`
+	TransferOutMinEach     decimal.Decimal `json:"transfer_out_min_each"`
+	TransferInMaxDaily     decimal.Decimal `json:"transfer_in_max_daily"`
+	TransferOutMaxDaily    decimal.Decimal `json:"transfer_out_max_daily"`
+	NetTransferInMaxDaily  decimal.Decimal `json:"net_transfer_in_max_daily"`
+	NetTransferOutMaxDaily decimal.Decimal `json:"net_transfer_out_max_daily"`
+}
+
+// TransferLimit get user transfer limits, symbol is optional
+func (h *Hbdm) TransferLimit(symbol string) (limits *TransferLimitResponse, err error) {
+	return h.TransferLimitCtx(context.Background(), symbol)
+}
+
+// TransferLimitCtx is TransferLimit with context for request cancellation and deadlines
+func (h *Hbdm) TransferLimitCtx(ctx context.Context, symbol string) (limits *TransferLimitResponse, err error) {
+	payload := make(Params, 1)
+	if symbol != "" {
+		payload.Set("symbol", symbol)
+	}
+
+	limits = new(TransferLimitResponse)
+	if err = h.client.call(ctx, "POST", "contract_transfer_limit", payload, true, true, limits); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// AccountPositionInfoResponse is response from AccountPositionInfo method
+type AccountPositionInfoResponse struct {
+	Response
+	Data []AccountPositionInfoData `json:"data"`
+}
+
+// AccountPositionInfoData is Account with its open positions data model
+type AccountPositionInfoData struct {
+	AccountInfoData
+	LeverRate    int                    `json:"lever_rate"`
+	AdjustFactor decimal.Decimal        `json:"adjust_factor"`
+	MarginStatic decimal.Decimal        `json:"margin_static"`
+	Positions    []ContractPositionData `json:"positions"`
+}
+
+// AccountPositionInfo return User’s Account Information with open positions for given symbol
+func (h *Hbdm) AccountPositionInfo(symbol string) (info *AccountPositionInfoResponse, err error) {
+	return h.AccountPositionInfoCtx(context.Background(), symbol)
+}
+
+// AccountPositionInfoCtx is AccountPositionInfo with context for request cancellation and deadlines
+func (h *Hbdm) AccountPositionInfoCtx(ctx context.Context, symbol string) (info *AccountPositionInfoResponse, err error) {
+	payload := make(Params, 1)
+	payload.Set("symbol", symbol)
+
+	info = new(AccountPositionInfoResponse)
+	if err = h.client.call(ctx, "POST", "contract_account_position_info", payload, true, true, info); err != nil {
+		return nil, err
+	}
+	return
+}
@@ -0,0 +1,356 @@
+package linearswap
+
+import (
+	"context"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/andskur/hbdm-go"
+)
+
+// apiPath is path prefix of USDT-margined swap API resources
+const apiPath = "/linear-swap-api/v1/"
+
+// Margin modes
+const (
+	MarginModeIsolated = "isolated"
+	MarginModeCross    = "cross"
+)
+
+// Client represent a hbdm USDT-margined linear swap API client, methods with Cross prefix
+// work with cross margin account, others with isolated margin accounts
+type Client struct {
+	hbdm *hbdm.Hbdm
+}
+
+// New returns an instantiated linear swap client configured with given hbdm options
+func New(apiKey, apiSecret string, opts ...hbdm.Option) *Client {
+	return NewWithHbdm(hbdm.New(apiKey, apiSecret, opts...))
+}
+
+// NewWithHbdm returns linear swap client sharing configuration, signing and nonce store with given hbdm client
+func NewWithHbdm(h *hbdm.Hbdm) *Client {
+	return &Client{hbdm: h}
+}
+
+// call process request to linear swap API method and decodes response into given result
+func (c *Client) call(ctx context.Context, method, resource string, payload hbdm.Params, authNeeded, idempotent bool, result hbdm.Enveloper) error {
+	return c.hbdm.Call(ctx, method, apiPath+resource, payload, authNeeded, idempotent, result)
+}
+
+// contractPayload returns payload with optional contract code
+func contractPayload(contractCode string) hbdm.Params {
+	payload := make(hbdm.Params, 1)
+	if contractCode != "" {
+		payload.Set("contract_code", contractCode)
+	}
+	return payload
+}
+
+// AccountInfoResponse is response for AccountInfo method
+type AccountInfoResponse struct {
+	hbdm.Response
+	Data []AccountInfoData `json:"data"`
+}
+
+// AccountInfoData is Isolated margin account data model
+type AccountInfoData struct {
+	Symbol            string          `json:"symbol"`
+	ContractCode      string          `json:"contract_code"`
+	MarginMode        string          `json:"margin_mode"`
+	MarginAccount     string          `json:"margin_account"`
+	MarginAsset       string          `json:"margin_asset"`
+	MarginBalance     decimal.Decimal `json:"margin_balance"`
+	MarginStatic      decimal.Decimal `json:"margin_static"`
+	MarginPosition    decimal.Decimal `json:"margin_position"`
+	MarginFrozen      decimal.Decimal `json:"margin_frozen"`
+	MarginAvailable   decimal.Decimal `json:"margin_available"`
+	ProfitReal        decimal.Decimal `json:"profit_real"`
+	ProfitUnreal      decimal.Decimal `json:"profit_unreal"`
+	WithdrawAvailable decimal.Decimal `json:"withdraw_available"`
+	RiskRate          decimal.Decimal `json:"risk_rate"`
+	LiquidationPrice  decimal.Decimal `json:"liquidation_price"`
+	AdjustFactor      decimal.Decimal `json:"adjust_factor"`
+	LeverRate         int             `json:"lever_rate"`
+}
+
+// AccountInfo return User’s isolated margin Accounts Information, contract code like "BTC-USDT" is optional
+func (c *Client) AccountInfo(contractCode string) (info *AccountInfoResponse, err error) {
+	return c.AccountInfoCtx(context.Background(), contractCode)
+}
+
+// AccountInfoCtx is AccountInfo with context for request cancellation and deadlines
+func (c *Client) AccountInfoCtx(ctx context.Context, contractCode string) (info *AccountInfoResponse, err error) {
+	info = new(AccountInfoResponse)
+	if err = c.call(ctx, "POST", "swap_account_info", contractPayload(contractCode), true, true, info); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// CrossAccountInfoResponse is response for CrossAccountInfo method
+type CrossAccountInfoResponse struct {
+	hbdm.Response
+	Data []CrossAccountInfoData `json:"data"`
+}
+
+// CrossAccountInfoData is Cross margin account data model
+type CrossAccountInfoData struct {
+	MarginMode        string          `json:"margin_mode"`
+	MarginAccount     string          `json:"margin_account"`
+	MarginAsset       string          `json:"margin_asset"`
+	MarginBalance     decimal.Decimal `json:"margin_balance"`
+	MarginStatic      decimal.Decimal `json:"margin_static"`
+	MarginPosition    decimal.Decimal `json:"margin_position"`
+	MarginFrozen      decimal.Decimal `json:"margin_frozen"`
+	ProfitReal        decimal.Decimal `json:"profit_real"`
+	ProfitUnreal      decimal.Decimal `json:"profit_unreal"`
+	WithdrawAvailable decimal.Decimal `json:"withdraw_available"`
+	RiskRate          decimal.Decimal `json:"risk_rate"`
+	ContractDetail    []struct {
+		Symbol           string          `json:"symbol"`
+		ContractCode     string          `json:"contract_code"`
+		MarginPosition   decimal.Decimal `json:"margin_position"`
+		MarginFrozen     decimal.Decimal `json:"margin_frozen"`
+		MarginAvailable  decimal.Decimal `json:"margin_available"`
+		ProfitUnreal     decimal.Decimal `json:"profit_unreal"`
+		LiquidationPrice decimal.Decimal `json:"liquidation_price"`
+		AdjustFactor     decimal.Decimal `json:"adjust_factor"`
+		LeverRate        int             `json:"lever_rate"`
+	} `json:"contract_detail"`
+}
+
+// CrossAccountInfo return User’s cross margin Accounts Information, margin account like "USDT" is optional
+func (c *Client) CrossAccountInfo(marginAccount string) (info *CrossAccountInfoResponse, err error) {
+	return c.CrossAccountInfoCtx(context.Background(), marginAccount)
+}
+
+// CrossAccountInfoCtx is CrossAccountInfo with context for request cancellation and deadlines
+func (c *Client) CrossAccountInfoCtx(ctx context.Context, marginAccount string) (info *CrossAccountInfoResponse, err error) {
+	payload := make(hbdm.Params, 1)
+	if marginAccount != "" {
+		payload.Set("margin_account", marginAccount)
+	}
+
+	info = new(CrossAccountInfoResponse)
+	if err = c.call(ctx, "POST", "swap_cross_account_info", payload, true, true, info); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// PositionInfoResponse is response from PositionInfo and CrossPositionInfo methods
+type PositionInfoResponse struct {
+	hbdm.Response
+	Data []PositionData `json:"data"`
+}
+
+// PositionData is Linear swap position data model
+type PositionData struct {
+	Symbol         string          `json:"symbol"`
+	ContractCode   string          `json:"contract_code"`
+	MarginMode     string          `json:"margin_mode"`
+	MarginAccount  string          `json:"margin_account"`
+	MarginAsset    string          `json:"margin_asset"`
+	Volume         decimal.Decimal `json:"volume"`
+	Available      decimal.Decimal `json:"available"`
+	Frozen         decimal.Decimal `json:"frozen"`
+	CostOpen       decimal.Decimal `json:"cost_open"`
+	CostHold       decimal.Decimal `json:"cost_hold"`
+	ProfitUnreal   decimal.Decimal `json:"profit_unreal"`
+	ProfitRate     decimal.Decimal `json:"profit_rate"`
+	Profit         decimal.Decimal `json:"profit"`
+	PositionMargin decimal.Decimal `json:"position_margin"`
+	LeverRate      int             `json:"lever_rate"`
+	Direction      string          `json:"direction"`
+	LastPrice      decimal.Decimal `json:"last_price"`
+}
+
+// PositionInfo get isolated margin open positions, contract code is optional
+func (c *Client) PositionInfo(contractCode string) (positions *PositionInfoResponse, err error) {
+	return c.PositionInfoCtx(context.Background(), contractCode)
+}
+
+// PositionInfoCtx is PositionInfo with context for request cancellation and deadlines
+func (c *Client) PositionInfoCtx(ctx context.Context, contractCode string) (positions *PositionInfoResponse, err error) {
+	return c.positionInfo(ctx, "swap_position_info", contractCode)
+}
+
+// CrossPositionInfo get cross margin open positions, contract code is optional
+func (c *Client) CrossPositionInfo(contractCode string) (positions *PositionInfoResponse, err error) {
+	return c.CrossPositionInfoCtx(context.Background(), contractCode)
+}
+
+// CrossPositionInfoCtx is CrossPositionInfo with context for request cancellation and deadlines
+func (c *Client) CrossPositionInfoCtx(ctx context.Context, contractCode string) (positions *PositionInfoResponse, err error) {
+	return c.positionInfo(ctx, "swap_cross_position_info", contractCode)
+}
+
+// positionInfo get open positions from given resource
+func (c *Client) positionInfo(ctx context.Context, resource, contractCode string) (positions *PositionInfoResponse, err error) {
+	positions = new(PositionInfoResponse)
+	if err = c.call(ctx, "POST", resource, contractPayload(contractCode), true, true, positions); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// OrderRequest is parameters of new linear swap order
+type OrderRequest = hbdm.SwapOrderRequest
+
+// OrderResponse is response from PlaceOrder and CrossPlaceOrder methods
+type OrderResponse struct {
+	hbdm.Response
+	Data struct {
+		OrderId       int64  `json:"order_id"`
+		OrderIdStr    string `json:"order_id_str"`
+		ClientOrderId int64  `json:"client_order_id"`
+	} `json:"data"`
+}
+
+// PlaceOrder validates and places isolated margin order
+func (c *Client) PlaceOrder(order OrderRequest) (resp *OrderResponse, err error) {
+	return c.PlaceOrderCtx(context.Background(), order)
+}
+
+// PlaceOrderCtx is PlaceOrder with context for request cancellation and deadlines
+func (c *Client) PlaceOrderCtx(ctx context.Context, order OrderRequest) (resp *OrderResponse, err error) {
+	return c.placeOrder(ctx, "swap_order", order)
+}
+
+// CrossPlaceOrder validates and places cross margin order
+func (c *Client) CrossPlaceOrder(order OrderRequest) (resp *OrderResponse, err error) {
+	return c.CrossPlaceOrderCtx(context.Background(), order)
+}
+
+// CrossPlaceOrderCtx is CrossPlaceOrder with context for request cancellation and deadlines
+func (c *Client) CrossPlaceOrderCtx(ctx context.Context, order OrderRequest) (resp *OrderResponse, err error) {
+	return c.placeOrder(ctx, "swap_cross_order", order)
+}
+
+// placeOrder validates and places order to given resource
+func (c *Client) placeOrder(ctx context.Context, resource string, order OrderRequest) (resp *OrderResponse, err error) {
+	if err = order.Validate(); err != nil {
+		return
+	}
+
+	if order.ClientOrderId == 0 {
+		if order.ClientOrderId, err = c.hbdm.GetAndIncrementNonce(); err != nil {
+			return
+		}
+	}
+
+	resp = new(OrderResponse)
+	if err = c.call(ctx, "POST", resource, order.Params(), true, false, resp); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// CancelResponse is response from cancel methods
+type CancelResponse struct {
+	hbdm.Response
+	Data struct {
+		Errors    []hbdm.CancelOrderError `json:"errors"`
+		Successes string                  `json:"successes"`
+	} `json:"data"`
+}
+
+// Cancel cancel isolated margin orders, orderIds and clientOrderIds are comma separated ID's, one of them is required
+func (c *Client) Cancel(contractCode, orderIds, clientOrderIds string) (resp *CancelResponse, err error) {
+	return c.CancelCtx(context.Background(), contractCode, orderIds, clientOrderIds)
+}
+
+// CancelCtx is Cancel with context for request cancellation and deadlines
+func (c *Client) CancelCtx(ctx context.Context, contractCode, orderIds, clientOrderIds string) (resp *CancelResponse, err error) {
+	return c.cancel(ctx, "swap_cancel", contractCode, orderIds, clientOrderIds)
+}
+
+// CrossCancel cancel cross margin orders, orderIds and clientOrderIds are comma separated ID's, one of them is required
+func (c *Client) CrossCancel(contractCode, orderIds, clientOrderIds string) (resp *CancelResponse, err error) {
+	return c.CrossCancelCtx(context.Background(), contractCode, orderIds, clientOrderIds)
+}
+
+// CrossCancelCtx is CrossCancel with context for request cancellation and deadlines
+func (c *Client) CrossCancelCtx(ctx context.Context, contractCode, orderIds, clientOrderIds string) (resp *CancelResponse, err error) {
+	return c.cancel(ctx, "swap_cross_cancel", contractCode, orderIds, clientOrderIds)
+}
+
+// cancel cancel orders with given resource
+func (c *Client) cancel(ctx context.Context, resource, contractCode, orderIds, clientOrderIds string) (resp *CancelResponse, err error) {
+	payload := make(hbdm.Params, 3)
+	payload.Set("contract_code", contractCode)
+	if orderIds != "" {
+		payload.Set("order_id", orderIds)
+	}
+	if clientOrderIds != "" {
+		payload.Set("client_order_id", clientOrderIds)
+	}
+
+	resp = new(CancelResponse)
+	if err = c.call(ctx, "POST", resource, payload, true, false, resp); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// CancelAll cancel all isolated margin orders of given contract
+func (c *Client) CancelAll(contractCode string) (resp *CancelResponse, err error) {
+	return c.CancelAllCtx(context.Background(), contractCode)
+}
+
+// CancelAllCtx is CancelAll with context for request cancellation and deadlines
+func (c *Client) CancelAllCtx(ctx context.Context, contractCode string) (resp *CancelResponse, err error) {
+	return c.cancelAll(ctx, "swap_cancelall", contractCode)
+}
+
+// CrossCancelAll cancel all cross margin orders of given contract
+func (c *Client) CrossCancelAll(contractCode string) (resp *CancelResponse, err error) {
+	return c.CrossCancelAllCtx(context.Background(), contractCode)
+}
+
+// CrossCancelAllCtx is CrossCancelAll with context for request cancellation and deadlines
+func (c *Client) CrossCancelAllCtx(ctx context.Context, contractCode string) (resp *CancelResponse, err error) {
+	return c.cancelAll(ctx, "swap_cross_cancelall", contractCode)
+}
+
+// cancelAll cancel all orders of contract with given resource
+func (c *Client) cancelAll(ctx context.Context, resource, contractCode string) (resp *CancelResponse, err error) {
+	payload := make(hbdm.Params, 1)
+	payload.Set("contract_code", contractCode)
+
+	resp = new(CancelResponse)
+	if err = c.call(ctx, "POST", resource, payload, true, false, resp); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// TransferResponse is response from Transfer method
+type TransferResponse struct {
+	hbdm.Response
+	Data struct {
+		OrderId string `json:"order_id"`
+	} `json:"data"`
+}
+
+// Transfer transfer asset between margin accounts, margin accounts are contract codes
+// like "BTC-USDT" for isolated and "USDT" for cross margin accounts
+func (c *Client) Transfer(asset, fromMarginAccount, toMarginAccount string, amount decimal.Decimal) (transfer *TransferResponse, err error) {
+	return c.TransferCtx(context.Background(), asset, fromMarginAccount, toMarginAccount, amount)
+}
+
+// TransferCtx is Transfer with context for request cancellation and deadlines
+func (c *Client) TransferCtx(ctx context.Context, asset, fromMarginAccount, toMarginAccount string, amount decimal.Decimal) (transfer *TransferResponse, err error) {
+	payload := make(hbdm.Params, 4)
+	payload.Set("asset", asset)
+	payload.Set("from_margin_account", fromMarginAccount)
+	payload.Set("to_margin_account", toMarginAccount)
+	payload.Set("amount", amount)
+
+	transfer = new(TransferResponse)
+	if err = c.call(ctx, "POST", "swap_transfer_inner", payload, true, false, transfer); err != nil {
+		return nil, err
+	}
+	return
+}
@@ -0,0 +1,125 @@
+package hbdm
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Logger is structured logger of client debug dumps and errors, keysAndValues are
+// alternating keys and values like in log/slog, so *slog.Logger satisfies it as is
+type Logger interface {
+	Debug(msg string, keysAndValues ...interface{})
+	Info(msg string, keysAndValues ...interface{})
+	Warn(msg string, keysAndValues ...interface{})
+	Error(msg string, keysAndValues ...interface{})
+}
+
+// stdLogger is Logger over standard library log.Logger
+type stdLogger struct {
+	logger *log.Logger
+}
+
+// NewStdLogger returns Logger writing "LEVEL msg key=value" lines to given log.Logger,
+// standard logger of log package is used if l is nil
+func NewStdLogger(l *log.Logger) Logger {
+	return stdLogger{logger: l}
+}
+
+// Debug implements Logger interface
+func (l stdLogger) Debug(msg string, keysAndValues ...interface{}) {
+	l.print("DEBUG", msg, keysAndValues)
+}
+
+// Info implements Logger interface
+func (l stdLogger) Info(msg string, keysAndValues ...interface{}) {
+	l.print("INFO", msg, keysAndValues)
+}
+
+// Warn implements Logger interface
+func (l stdLogger) Warn(msg string, keysAndValues ...interface{}) {
+	l.print("WARN", msg, keysAndValues)
+}
+
+// Error implements Logger interface
+func (l stdLogger) Error(msg string, keysAndValues ...interface{}) {
+	l.print("ERROR", msg, keysAndValues)
+}
+
+// print formats and writes log line
+func (l stdLogger) print(level, msg string, keysAndValues []interface{}) {
+	var b strings.Builder
+	b.WriteString(level)
+	b.WriteString(" ")
+	b.WriteString(msg)
+
+	for i := 0; i < len(keysAndValues); i += 2 {
+		if i+1 == len(keysAndValues) {
+			fmt.Fprintf(&b, " !BADKEY=%v", keysAndValues[i])
+			break
+		}
+		fmt.Fprintf(&b, " %v=%v", keysAndValues[i], keysAndValues[i+1])
+	}
+
+	if l.logger == nil {
+		log.Print(b.String())
+		return
+	}
+	l.logger.Print(b.String())
+}
+
+// logrusLogger is Logger over logrus logger
+type logrusLogger struct {
+	logger logrus.FieldLogger
+}
+
+// NewLogrusLogger returns Logger writing to given logrus logger or entry,
+// key and value pairs are passed as logrus fields
+func NewLogrusLogger(l logrus.FieldLogger) Logger {
+	return logrusLogger{logger: l}
+}
+
+// Debug implements Logger interface
+func (l logrusLogger) Debug(msg string, keysAndValues ...interface{}) {
+	l.logger.WithFields(fields(keysAndValues)).Debug(msg)
+}
+
+// Info implements Logger interface
+func (l logrusLogger) Info(msg string, keysAndValues ...interface{}) {
+	l.logger.WithFields(fields(keysAndValues)).Info(msg)
+}
+
+// Warn implements Logger interface
+func (l logrusLogger) Warn(msg string, keysAndValues ...interface{}) {
+	l.logger.WithFields(fields(keysAndValues)).Warn(msg)
+}
+
+// Error implements Logger interface
+func (l logrusLogger) Error(msg string, keysAndValues ...interface{}) {
+	l.logger.WithFields(fields(keysAndValues)).Error(msg)
+}
+
+// fields converts alternating keys and values to fields map, value without key
+// is stored under "!BADKEY" like in log/slog
+func fields(keysAndValues []interface{}) logrus.Fields {
+	f := make(logrus.Fields, len(keysAndValues)/2)
+	for i := 0; i < len(keysAndValues); i += 2 {
+		if i+1 == len(keysAndValues) {
+			f["!BADKEY"] = keysAndValues[i]
+			break
+		}
+		f[fmt.Sprint(keysAndValues[i])] = keysAndValues[i+1]
+	}
+	return f
+}
+
+// secretsPattern matches access key and signature values in query strings and JSON messages
+var secretsPattern = regexp.MustCompile(`("?(?:AccessKeyId|Signature)"?\s*[=:]\s*"?)[^&"\s]+`)
+
+// Redact replaces API access key and signature values in given request dump or message
+func Redact(s string) string {
+	return secretsPattern.ReplaceAllString(s, "${1}REDACTED")
+}
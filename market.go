@@ -0,0 +1,327 @@
+package hbdm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+// contractFilterPayload returns payload for contract filtered public methods
+func contractFilterPayload(symbol, contractType, contractCode string) Params {
+	payload := make(Params, 3)
+	if symbol != "" {
+		payload.Set("symbol", symbol)
+	}
+	if contractType != "" {
+		payload.Set("contract_type", contractType)
+	}
+	if contractCode != "" {
+		payload.Set("contract_code", contractCode)
+	}
+	return payload
+}
+
+// ContractInfoResponse is response for ContractInfo method
+type ContractInfoResponse struct {
+	Response
+	Data []ContractInfoData `json:"data"`
+}
+
+// ContractInfoData is Contract data model
+type ContractInfoData struct {
+	Symbol         string          `json:"symbol"`
+	ContractCode   string          `json:"contract_code"`
+	ContractType   string          `json:"contract_type"`
+	ContractSize   decimal.Decimal `json:"contract_size"`
+	PriceTick      decimal.Decimal `json:"price_tick"`
+	DeliveryDate   string          `json:"delivery_date"`
+	CreateDate     string          `json:"create_date"`
+	ContractStatus int             `json:"contract_status"`
+}
+
+// ContractInfo get Contracts Information by given filters, all filters are optional
+func (h *Hbdm) ContractInfo(symbol, contractType, contractCode string) (info *ContractInfoResponse, err error) {
+	return h.ContractInfoCtx(context.Background(), symbol, contractType, contractCode)
+}
+
+// ContractInfoCtx is ContractInfo with context for request cancellation and deadlines
+func (h *Hbdm) ContractInfoCtx(ctx context.Context, symbol, contractType, contractCode string) (info *ContractInfoResponse, err error) {
+	payload := contractFilterPayload(symbol, contractType, contractCode)
+
+	info = new(ContractInfoResponse)
+	if err = h.client.call(ctx, "GET", "contract_contract_info", payload, false, true, info); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// PriceLimitResponse is response for PriceLimit method
+type PriceLimitResponse struct {
+	Response
+	Data []PriceLimitData `json:"data"`
+}
+
+// PriceLimitData is Contract price limits data model
+type PriceLimitData struct {
+	Symbol       string          `json:"symbol"`
+	ContractType string          `json:"contract_type"`
+	ContractCode string          `json:"contract_code"`
+	HighLimit    decimal.Decimal `json:"high_limit"`
+	LowLimit     decimal.Decimal `json:"low_limit"`
+}
+
+// PriceLimit get highest and lowest allowed order price of Contracts by given filters
+func (h *Hbdm) PriceLimit(symbol, contractType, contractCode string) (limits *PriceLimitResponse, err error) {
+	return h.PriceLimitCtx(context.Background(), symbol, contractType, contractCode)
+}
+
+// PriceLimitCtx is PriceLimit with context for request cancellation and deadlines
+func (h *Hbdm) PriceLimitCtx(ctx context.Context, symbol, contractType, contractCode string) (limits *PriceLimitResponse, err error) {
+	payload := contractFilterPayload(symbol, contractType, contractCode)
+
+	limits = new(PriceLimitResponse)
+	if err = h.client.call(ctx, "GET", "contract_price_limit", payload, false, true, limits); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// OpenInterestResponse is response for OpenInterest method
+type OpenInterestResponse struct {
+	Response
+	Data []OpenInterestData `json:"data"`
+}
+
+// OpenInterestData is Contract open interest data model
+type OpenInterestData struct {
+	Symbol       string          `json:"symbol"`
+	ContractType string          `json:"contract_type"`
+	ContractCode string          `json:"contract_code"`
+	Volume       decimal.Decimal `json:"volume"`
+	Amount       decimal.Decimal `json:"amount"`
+}
+
+// OpenInterest get total open interest of Contracts by given filters
+func (h *Hbdm) OpenInterest(symbol, contractType, contractCode string) (interest *OpenInterestResponse, err error) {
+	return h.OpenInterestCtx(context.Background(), symbol, contractType, contractCode)
+}
+
+// OpenInterestCtx is OpenInterest with context for request cancellation and deadlines
+func (h *Hbdm) OpenInterestCtx(ctx context.Context, symbol, contractType, contractCode string) (interest *OpenInterestResponse, err error) {
+	payload := contractFilterPayload(symbol, contractType, contractCode)
+
+	interest = new(OpenInterestResponse)
+	if err = h.client.call(ctx, "GET", "contract_open_interest", payload, false, true, interest); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// Offer is Offer with Contract Price and Amount
+type Offer struct {
+	Price  decimal.Decimal `json:"price"`
+	Amount decimal.Decimal `json:"amount"`
+}
+
+// UnmarshalJSON make correct Json Unmarshaling fro Offer structure
+func (o *Offer) UnmarshalJSON(b []byte) error {
+	var offer []decimal.Decimal
+
+	if err := json.Unmarshal(b, &offer); err != nil {
+		return fmt.Errorf("unmarshalling: %v", err)
+	}
+
+	if len(offer) < 2 {
+		return fmt.Errorf("unmarshalling: offer %s has no price or amount", b)
+	}
+
+	o.Price = offer[0]
+	o.Amount = offer[1]
+	return nil
+}
+
+// MarketDepthResponse is response for MarketDepth method
+type MarketDepthResponse struct {
+	Response
+	Ch   string          `json:"ch"`
+	Tick MarketDepthTick `json:"tick"`
+}
+
+// MarketDepthTick is Depth Offer main data
+type MarketDepthTick struct {
+	Ch      string  `json:"ch"`
+	Mrid    int64   `json:"mrid"`
+	Id      int64   `json:"id"`
+	Ts      int     `json:"ts"`
+	Version int     `json:"version"`
+	Bids    []Offer `json:"bids"`
+	Asks    []Offer `json:"asks"`
+}
+
+// MarketDepth get order book of contract, symbol is contract symbol like "BTC_CQ",
+// depthType is aggregation level from "step0" to "step5"
+func (h *Hbdm) MarketDepth(symbol, depthType string) (depth *MarketDepthResponse, err error) {
+	return h.MarketDepthCtx(context.Background(), symbol, depthType)
+}
+
+// MarketDepthCtx is MarketDepth with context for request cancellation and deadlines
+func (h *Hbdm) MarketDepthCtx(ctx context.Context, symbol, depthType string) (depth *MarketDepthResponse, err error) {
+	payload := make(Params, 2)
+	payload.Set("symbol", symbol)
+	payload.Set("type", depthType)
+
+	depth = new(MarketDepthResponse)
+	if err = h.client.call(ctx, "GET", "/market/depth", payload, false, true, depth); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// KlineResponse is response for Kline method
+type KlineResponse struct {
+	Response
+	Ch   string      `json:"ch"`
+	Data []KlineData `json:"data"`
+}
+
+// KlineData is Candlestick data model
+type KlineData struct {
+	Id     int64           `json:"id"`
+	Open   decimal.Decimal `json:"open"`
+	Close  decimal.Decimal `json:"close"`
+	High   decimal.Decimal `json:"high"`
+	Low    decimal.Decimal `json:"low"`
+	Amount decimal.Decimal `json:"amount"`
+	Vol    decimal.Decimal `json:"vol"`
+	Count  int             `json:"count"`
+}
+
+// Kline get candlesticks of contract, period is one of "1min", "5min", "15min", "30min",
+// "60min", "4hour", "1day", "1mon", size is number of candlesticks from 1 to 2000
+func (h *Hbdm) Kline(symbol, period string, size int) (kline *KlineResponse, err error) {
+	return h.KlineCtx(context.Background(), symbol, period, size)
+}
+
+// KlineCtx is Kline with context for request cancellation and deadlines
+func (h *Hbdm) KlineCtx(ctx context.Context, symbol, period string, size int) (kline *KlineResponse, err error) {
+	payload := make(Params, 3)
+	payload.Set("symbol", symbol)
+	payload.Set("period", period)
+	if size != 0 {
+		payload.Set("size", size)
+	}
+
+	kline = new(KlineResponse)
+	if err = h.client.call(ctx, "GET", "/market/history/kline", payload, false, true, kline); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// MergedTickerResponse is response for MergedTicker method
+type MergedTickerResponse struct {
+	Response
+	Ch   string           `json:"ch"`
+	Tick MergedTickerTick `json:"tick"`
+}
+
+// MergedTickerTick is Merged ticker data model
+type MergedTickerTick struct {
+	Id     int64           `json:"id"`
+	Ts     int             `json:"ts"`
+	Open   decimal.Decimal `json:"open"`
+	Close  decimal.Decimal `json:"close"`
+	High   decimal.Decimal `json:"high"`
+	Low    decimal.Decimal `json:"low"`
+	Amount decimal.Decimal `json:"amount"`
+	Vol    decimal.Decimal `json:"vol"`
+	Count  int             `json:"count"`
+	Ask    Offer           `json:"ask"`
+	Bid    Offer           `json:"bid"`
+}
+
+// MergedTicker get 24 hours market summary with best bid and ask of contract
+func (h *Hbdm) MergedTicker(symbol string) (ticker *MergedTickerResponse, err error) {
+	return h.MergedTickerCtx(context.Background(), symbol)
+}
+
+// MergedTickerCtx is MergedTicker with context for request cancellation and deadlines
+func (h *Hbdm) MergedTickerCtx(ctx context.Context, symbol string) (ticker *MergedTickerResponse, err error) {
+	payload := make(Params, 1)
+	payload.Set("symbol", symbol)
+
+	ticker = new(MergedTickerResponse)
+	if err = h.client.call(ctx, "GET", "/market/detail/merged", payload, false, true, ticker); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// MarketTradeData is Market trade data model
+type MarketTradeData struct {
+	Id        int64           `json:"id"`
+	Ts        int             `json:"ts"`
+	Price     decimal.Decimal `json:"price"`
+	Amount    decimal.Decimal `json:"amount"`
+	Direction string          `json:"direction"`
+}
+
+// MarketTradeTick is Market trades batch
+type MarketTradeTick struct {
+	Id   int64             `json:"id"`
+	Ts   int               `json:"ts"`
+	Data []MarketTradeData `json:"data"`
+}
+
+// MarketTradeResponse is response for MarketTrade method
+type MarketTradeResponse struct {
+	Response
+	Ch   string          `json:"ch"`
+	Tick MarketTradeTick `json:"tick"`
+}
+
+// MarketTrade get last trade of contract
+func (h *Hbdm) MarketTrade(symbol string) (trade *MarketTradeResponse, err error) {
+	return h.MarketTradeCtx(context.Background(), symbol)
+}
+
+// MarketTradeCtx is MarketTrade with context for request cancellation and deadlines
+func (h *Hbdm) MarketTradeCtx(ctx context.Context, symbol string) (trade *MarketTradeResponse, err error) {
+	payload := make(Params, 1)
+	payload.Set("symbol", symbol)
+
+	trade = new(MarketTradeResponse)
+	if err = h.client.call(ctx, "GET", "/market/trade", payload, false, true, trade); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// HistoryTradeResponse is response for HistoryTrade method
+type HistoryTradeResponse struct {
+	Response
+	Ch   string            `json:"ch"`
+	Data []MarketTradeTick `json:"data"`
+}
+
+// HistoryTrade get batch of recent trades of contract, size is number of trades from 1 to 2000
+func (h *Hbdm) HistoryTrade(symbol string, size int) (trades *HistoryTradeResponse, err error) {
+	return h.HistoryTradeCtx(context.Background(), symbol, size)
+}
+
+// HistoryTradeCtx is HistoryTrade with context for request cancellation and deadlines
+func (h *Hbdm) HistoryTradeCtx(ctx context.Context, symbol string, size int) (trades *HistoryTradeResponse, err error) {
+	payload := make(Params, 2)
+	payload.Set("symbol", symbol)
+	if size != 0 {
+		payload.Set("size", size)
+	}
+
+	trades = new(HistoryTradeResponse)
+	if err = h.client.call(ctx, "GET", "/market/history/trade", payload, false, true, trades); err != nil {
+		return nil, err
+	}
+	return
+}
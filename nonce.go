@@ -1,67 +1,62 @@
 package hbdm
 
 import (
-	"errors"
-	"io/ioutil"
-	"os"
-	"strconv"
+	"sync/atomic"
+	"time"
 )
 
-const (
-	nonceFile = "data/nonce"
-)
+// defaultNonceFile is file used by default nonce store
+const defaultNonceFile = "data/nonce"
 
-var (
-	errREadNonceFile = errors.New("nonce file read error")
-)
+// NonceStore generates unique client order id's
+type NonceStore interface {
+	// Next returns next unique nonce
+	Next() (int64, error)
+}
 
-func (h *Hbdm) GetAndIncrementNonce() (nonce uint64, err error) {
-	h.mu.Lock()
-	defer h.mu.Unlock()
-	nonce, err = readNonce()
-	if err != nil {
-		return
-	}
-	err = incrementNonce(&nonce)
-	return
+// GetAndIncrementNonce returns next client order id from configured nonce store
+func (h *Hbdm) GetAndIncrementNonce() (nonce int64, err error) {
+	return h.nonce.Next()
 }
 
-func readNonce() (nonce uint64, err error) {
-	if err = CreateNonceFileIfNotExists(); err != nil {
-		return
-	}
-	data, err := ioutil.ReadFile(nonceFile)
-	if err != nil {
-		return 0, errREadNonceFile
-	}
-	nonce, err = strconv.ParseUint(string(data), 10, 64)
-	return
+// MemoryNonceStore is in-memory nonce store, it's unique only within one process
+type MemoryNonceStore struct {
+	nonce int64
+}
+
+// NewMemoryNonceStore returns in-memory nonce store starting after given nonce
+func NewMemoryNonceStore(start int64) *MemoryNonceStore {
+	return &MemoryNonceStore{nonce: start}
+}
+
+// Next returns next nonce
+func (s *MemoryNonceStore) Next() (int64, error) {
+	return atomic.AddInt64(&s.nonce, 1), nil
 }
-func WriteNonce(data []byte) (err error) {
-	err = ioutil.WriteFile(nonceFile, data, 0644)
-	return
+
+// TimeNonceStore is monotonic nonce generator seeded with current time in nanoseconds,
+// it's unique across processes as long as they don't generate nonces at the same nanosecond
+type TimeNonceStore struct {
+	last int64
 }
 
-func incrementNonce(nonceOld *uint64) (err error) {
-	*nonceOld = *nonceOld + 1
-	ns := strconv.FormatUint(*nonceOld, 10)
-	err = WriteNonce([]byte(ns))
-	return
+// NewTimeNonceStore returns time-seeded monotonic nonce store
+func NewTimeNonceStore() *TimeNonceStore {
+	return &TimeNonceStore{}
 }
 
-func CreateNonceFileIfNotExists() (err error) {
-	if _, err := os.Stat("data"); os.IsNotExist(err) {
-		if err := os.Mkdir("data", 0700); err != nil {
-			return err
+// Next returns current time in nanoseconds or previous nonce + 1 if clock didn't move forward
+func (s *TimeNonceStore) Next() (int64, error) {
+	for {
+		last := atomic.LoadInt64(&s.last)
+
+		next := time.Now().UnixNano()
+		if next <= last {
+			next = last + 1
 		}
-	}
-	if _, err = os.Stat(nonceFile); os.IsNotExist(err) {
-		if _, err = os.Create(nonceFile); err != nil {
-			return err
+
+		if atomic.CompareAndSwapInt64(&s.last, last, next) {
+			return next, nil
 		}
-		d1 := []byte("1")
-		err = WriteNonce(d1)
-		return
 	}
-	return err
 }
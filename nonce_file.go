@@ -0,0 +1,134 @@
+package hbdm
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+var (
+	errREadNonceFile = errors.New("nonce file read error")
+)
+
+// FileNonceStore is nonce store persisted in file, it's shared between processes with
+// file lock and updated by atomic rename
+type FileNonceStore struct {
+	path string
+	mu   sync.Mutex
+}
+
+// NewFileNonceStore returns nonce store persisted in given file
+func NewFileNonceStore(path string) *FileNonceStore {
+	return &FileNonceStore{path: path}
+}
+
+// Next increments nonce stored in file and returns it
+func (s *FileNonceStore) Next() (nonce int64, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err = os.MkdirAll(filepath.Dir(s.path), 0700); err != nil {
+		return
+	}
+
+	lock, err := os.OpenFile(s.path+".lock", os.O_CREATE|os.O_RDWR, 0644)
+	if err != nil {
+		return
+	}
+	defer lock.Close()
+
+	if err = lockFile(lock); err != nil {
+		return
+	}
+	defer unlockFile(lock)
+
+	nonce, err = s.read()
+	if err != nil {
+		return
+	}
+
+	nonce++
+	err = s.write(nonce)
+	return
+}
+
+// read returns nonce stored in file, zero if file doesn't exist. Empty or malformed file
+// is an error, restarting from zero would reuse client order id's
+func (s *FileNonceStore) read() (int64, error) {
+	data, err := ioutil.ReadFile(s.path)
+	if os.IsNotExist(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, errREadNonceFile
+	}
+
+	str := strings.TrimSpace(string(data))
+	if str == "" {
+		return 0, fmt.Errorf("%w: %s is empty", errREadNonceFile, s.path)
+	}
+
+	nonce, err := strconv.ParseInt(str, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %s: %v", errREadNonceFile, s.path, err)
+	}
+	return nonce, nil
+}
+
+// write atomically replaces nonce file content with given nonce
+func (s *FileNonceStore) write(nonce int64) error {
+	return writeFileAtomic(s.path, []byte(strconv.FormatInt(nonce, 10)))
+}
+
+// writeFileAtomic replaces file content with given data, data is synced to disk
+// before rename so file is never left empty or truncated after crash
+func writeFileAtomic(path string, data []byte) error {
+	tmp, err := ioutil.TempFile(filepath.Dir(path), filepath.Base(path)+".tmp")
+	if err != nil {
+		return err
+	}
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
+
+// WriteNonce replaces content of default nonce file with given data
+//
+// Deprecated: use FileNonceStore, it's set by default or by WithNonceStore option
+func WriteNonce(data []byte) (err error) {
+	return writeFileAtomic(defaultNonceFile, data)
+}
+
+// CreateNonceFileIfNotExists creates default nonce file starting with 1 if it doesn't exist
+//
+// Deprecated: FileNonceStore creates nonce file on first use
+func CreateNonceFileIfNotExists() (err error) {
+	if err = os.MkdirAll(filepath.Dir(defaultNonceFile), 0700); err != nil {
+		return
+	}
+	if _, err = os.Stat(defaultNonceFile); os.IsNotExist(err) {
+		return WriteNonce([]byte("1"))
+	}
+	return err
+}
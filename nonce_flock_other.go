@@ -0,0 +1,18 @@
+//go:build !linux && !darwin && !freebsd && !netbsd && !openbsd && !dragonfly
+// +build !linux,!darwin,!freebsd,!netbsd,!openbsd,!dragonfly
+
+package hbdm
+
+import (
+	"os"
+)
+
+// lockFile is no-op on platforms without flock, file store is safe only within one process
+func lockFile(f *os.File) error {
+	return nil
+}
+
+// unlockFile is no-op on platforms without flock
+func unlockFile(f *os.File) error {
+	return nil
+}
@@ -0,0 +1,39 @@
+package hbdm_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/andskur/hbdm-go"
+)
+
+func TestFileNonceStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "nonce")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "data", "nonce")
+	store := hbdm.NewFileNonceStore(path)
+
+	for want := int64(1); want <= 3; want++ {
+		nonce, err := store.Next()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if nonce != want {
+			t.Errorf("nonce = %d, want %d", nonce, want)
+		}
+	}
+
+	// truncated file must not restart nonces
+	if err := ioutil.WriteFile(path, nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	if nonce, err := store.Next(); err == nil {
+		t.Errorf("got nonce %d from empty file, want error", nonce)
+	}
+}
@@ -0,0 +1,114 @@
+package hbdm
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Endpoints is set of hbdm REST and Websocket API URL's
+type Endpoints struct {
+	// REST is REST API base url without path, e.g. https://api.hbdm.com
+	REST string
+	// SignHost is host used in requests signature, REST url host is used if empty
+	SignHost string
+	// WSMarket is market data Websocket API url
+	WSMarket string
+	// WSOrders is orders notification Websocket API url
+	WSOrders string
+	// Spot is Huobi spot REST API base url, used for transfers between spot and futures accounts,
+	// transfers fail with ErrNoSpotEndpoint if it's empty
+	Spot string
+	// SpotSignHost is host used in spot requests signature, Spot url host is used if empty
+	SpotSignHost string
+}
+
+// DefaultEndpoints is hbdm production API endpoints
+var DefaultEndpoints = Endpoints{
+	REST:     "https://api.hbdm.com",
+	WSMarket: "wss://www.hbdm.com/ws",
+	WSOrders: "wss://api.hbdm.com/notification",
+	Spot:     "https://api.huobi.pro",
+}
+
+// BtcGatewayEndpoints is hbdm API mirror endpoints at api.btcgateway.pro
+var BtcGatewayEndpoints = Endpoints{
+	REST:     "https://api.btcgateway.pro",
+	WSMarket: "wss://api.btcgateway.pro/ws",
+	WSOrders: "wss://api.btcgateway.pro/notification",
+	Spot:     "https://api.huobi.pro",
+}
+
+// signHost returns host for signature of requests to given base url
+func signHost(base, override string) string {
+	if override != "" {
+		return override
+	}
+
+	u, err := url.Parse(base)
+	if err != nil {
+		return ""
+	}
+
+	return u.Host
+}
+
+// Option configures Hbdm client
+type Option func(h *Hbdm)
+
+// WithEndpoints sets REST API base url, signing host and Websocket API url's
+func WithEndpoints(endpoints Endpoints) Option {
+	return func(h *Hbdm) {
+		endpoints.REST = strings.TrimRight(endpoints.REST, "/")
+		endpoints.Spot = strings.TrimRight(endpoints.Spot, "/")
+		h.client.endpoints = endpoints
+	}
+}
+
+// WithHttpClient sets custom http client
+func WithHttpClient(httpClient *http.Client) Option {
+	return func(h *Hbdm) {
+		h.client.httpClient = httpClient
+		if httpClient.Timeout > 0 {
+			h.client.httpTimeout = httpClient.Timeout
+		}
+	}
+}
+
+// WithTimeout sets custom http requests timeout
+func WithTimeout(timeout time.Duration) Option {
+	return func(h *Hbdm) {
+		h.client.httpTimeout = timeout
+	}
+}
+
+// WithNonceStore sets store of client order id's, data/nonce file store is used by default
+func WithNonceStore(store NonceStore) Option {
+	return func(h *Hbdm) {
+		h.nonce = store
+	}
+}
+
+// WithLogger sets logger of debug dumps and errors, log package standard logger is used by default
+func WithLogger(logger Logger) Option {
+	return func(h *Hbdm) {
+		h.client.logger = logger
+	}
+}
+
+// WithRateLimiter sets rate limiter of API requests, pass the same limiter to clients sharing
+// one API key, nil disables rate limiting. Limiter with DefaultRateLimits is used by default
+func WithRateLimiter(limiter *RateLimiter) Option {
+	return func(h *Hbdm) {
+		h.client.limiter = limiter
+	}
+}
+
+// WithRetryPolicy sets retry policy of transient API failures, DefaultRetryPolicy is used by default,
+// use zero RetryPolicy to disable retries
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(h *Hbdm) {
+		h.client.retry = policy
+	}
+}
@@ -0,0 +1,255 @@
+package hbdm
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+// Direction is order direction
+type Direction string
+
+// Order directions
+const (
+	DirectionBuy  Direction = "buy"
+	DirectionSell Direction = "sell"
+)
+
+// Valid reports whether direction is known
+func (d Direction) Valid() bool {
+	return d == DirectionBuy || d == DirectionSell
+}
+
+// Offset is order offset, open or close position
+type Offset string
+
+// Order offsets
+const (
+	OffsetOpen  Offset = "open"
+	OffsetClose Offset = "close"
+)
+
+// Valid reports whether offset is known
+func (o Offset) Valid() bool {
+	return o == OffsetOpen || o == OffsetClose
+}
+
+// OrderPriceType is order price type
+type OrderPriceType string
+
+// Order price types
+const (
+	PriceTypeLimit        OrderPriceType = "limit"
+	PriceTypeOpponent     OrderPriceType = "opponent"
+	PriceTypePostOnly     OrderPriceType = "post_only"
+	PriceTypeIOC          OrderPriceType = "ioc"
+	PriceTypeFOK          OrderPriceType = "fok"
+	PriceTypeOpponentIOC  OrderPriceType = "opponent_ioc"
+	PriceTypeOpponentFOK  OrderPriceType = "opponent_fok"
+	PriceTypeOptimal5     OrderPriceType = "optimal_5"
+	PriceTypeOptimal10    OrderPriceType = "optimal_10"
+	PriceTypeOptimal20    OrderPriceType = "optimal_20"
+	PriceTypeOptimal5IOC  OrderPriceType = "optimal_5_ioc"
+	PriceTypeOptimal10IOC OrderPriceType = "optimal_10_ioc"
+	PriceTypeOptimal20IOC OrderPriceType = "optimal_20_ioc"
+	PriceTypeOptimal5FOK  OrderPriceType = "optimal_5_fok"
+	PriceTypeOptimal10FOK OrderPriceType = "optimal_10_fok"
+	PriceTypeOptimal20FOK OrderPriceType = "optimal_20_fok"
+)
+
+// Valid reports whether order price type is known
+func (t OrderPriceType) Valid() bool {
+	switch t {
+	case PriceTypeLimit, PriceTypeOpponent, PriceTypePostOnly, PriceTypeIOC, PriceTypeFOK,
+		PriceTypeOpponentIOC, PriceTypeOpponentFOK,
+		PriceTypeOptimal5, PriceTypeOptimal10, PriceTypeOptimal20,
+		PriceTypeOptimal5IOC, PriceTypeOptimal10IOC, PriceTypeOptimal20IOC,
+		PriceTypeOptimal5FOK, PriceTypeOptimal10FOK, PriceTypeOptimal20FOK:
+		return true
+	}
+	return false
+}
+
+// NeedsPrice reports whether order with price type must have price
+func (t OrderPriceType) NeedsPrice() bool {
+	switch t {
+	case PriceTypeLimit, PriceTypePostOnly, PriceTypeIOC, PriceTypeFOK:
+		return true
+	}
+	return false
+}
+
+// ContractType is delivery period of contract
+type ContractType string
+
+// Contract types
+const (
+	ContractThisWeek    ContractType = "this_week"
+	ContractNextWeek    ContractType = "next_week"
+	ContractQuarter     ContractType = "quarter"
+	ContractNextQuarter ContractType = "next_quarter"
+)
+
+// Valid reports whether contract type is known
+func (t ContractType) Valid() bool {
+	switch t {
+	case ContractThisWeek, ContractNextWeek, ContractQuarter, ContractNextQuarter:
+		return true
+	}
+	return false
+}
+
+// OrderRequest is parameters of new contract order, contract is set
+// by Symbol with ContractType or by ContractCode
+type OrderRequest struct {
+	Symbol         string
+	ContractType   ContractType
+	ContractCode   string
+	ClientOrderId  int64 // generated by nonce store if zero
+	Price          decimal.Decimal
+	PriceTick      decimal.Decimal // contract price tick, price is sent rounded to it if set
+	Volume         int
+	Direction      Direction
+	Offset         Offset
+	LeverRate      int
+	OrderPriceType OrderPriceType
+}
+
+// validateContract checks that contract is set by symbol with contract type or by contract code
+func validateContract(symbol string, contractType ContractType, contractCode string) error {
+	if contractCode == "" {
+		if symbol == "" {
+			return fmt.Errorf("%w: symbol or contract code is required", ErrInvalidOrder)
+		}
+		if !contractType.Valid() {
+			return fmt.Errorf("%w: contract type %q", ErrInvalidOrder, contractType)
+		}
+	} else if contractType != "" && !contractType.Valid() {
+		return fmt.Errorf("%w: contract type %q", ErrInvalidOrder, contractType)
+	}
+	return nil
+}
+
+// Validate checks order parameters before sending
+func (o OrderRequest) Validate() error {
+	if err := validateContract(o.Symbol, o.ContractType, o.ContractCode); err != nil {
+		return err
+	}
+
+	return validateOrder(o.Price, o.Volume, o.Direction, o.Offset, o.LeverRate, o.OrderPriceType)
+}
+
+// validateOrder checks order parameters common for contract and swap orders
+func validateOrder(price decimal.Decimal, volume int, direction Direction, offset Offset, leverRate int, orderPriceType OrderPriceType) error {
+	if !direction.Valid() {
+		return fmt.Errorf("%w: direction %q", ErrInvalidOrder, direction)
+	}
+
+	if !offset.Valid() {
+		return fmt.Errorf("%w: offset %q", ErrInvalidOrder, offset)
+	}
+
+	if !orderPriceType.Valid() {
+		return fmt.Errorf("%w: order price type %q", ErrInvalidOrder, orderPriceType)
+	}
+
+	if orderPriceType.NeedsPrice() && !price.IsPositive() {
+		return fmt.Errorf("%w: price %v for %s order", ErrInvalidOrder, price, orderPriceType)
+	}
+
+	if price.IsNegative() {
+		return fmt.Errorf("%w: price %v", ErrInvalidOrder, price)
+	}
+
+	if volume <= 0 {
+		return fmt.Errorf("%w: volume %d", ErrInvalidOrder, volume)
+	}
+
+	if leverRate <= 0 {
+		return fmt.Errorf("%w: lever rate %d", ErrInvalidOrder, leverRate)
+	}
+
+	return nil
+}
+
+// payload returns API request payload of order
+func (o OrderRequest) payload() Params {
+	payload := make(Params, 10)
+	payload.Set("client_order_id", o.ClientOrderId)
+	payload.Set("volume", o.Volume)
+	payload.Set("direction", string(o.Direction))
+	payload.Set("offset", string(o.Offset))
+	payload.Set("lever_rate", o.LeverRate)
+	payload.Set("order_price_type", string(o.OrderPriceType))
+
+	if o.Symbol != "" {
+		payload.Set("symbol", o.Symbol)
+	}
+	if o.ContractType != "" {
+		payload.Set("contract_type", string(o.ContractType))
+	}
+	if o.ContractCode != "" {
+		payload.Set("contract_code", o.ContractCode)
+	}
+	if !o.Price.IsZero() {
+		payload.Set("price", FormatPrice(o.Price, o.PriceTick))
+	}
+
+	return payload
+}
+
+// SwapOrderRequest is parameters of new perpetual swap order, it's used by swap
+// and linearswap packages, contract is set by ContractCode like "BTC-USD"
+type SwapOrderRequest struct {
+	ContractCode   string
+	ClientOrderId  int64 // generated by nonce store if zero
+	Price          decimal.Decimal
+	PriceTick      decimal.Decimal // contract price tick, price is sent rounded to it if set
+	Volume         int
+	Direction      Direction
+	Offset         Offset
+	LeverRate      int
+	OrderPriceType OrderPriceType
+}
+
+// Validate checks swap order parameters before sending
+func (o SwapOrderRequest) Validate() error {
+	if o.ContractCode == "" {
+		return fmt.Errorf("%w: contract code is required", ErrInvalidOrder)
+	}
+
+	return validateOrder(o.Price, o.Volume, o.Direction, o.Offset, o.LeverRate, o.OrderPriceType)
+}
+
+// Params returns API request payload of swap order
+func (o SwapOrderRequest) Params() Params {
+	payload := make(Params, 8)
+	payload.Set("contract_code", o.ContractCode)
+	payload.Set("client_order_id", o.ClientOrderId)
+	payload.Set("volume", o.Volume)
+	payload.Set("direction", string(o.Direction))
+	payload.Set("offset", string(o.Offset))
+	payload.Set("lever_rate", o.LeverRate)
+	payload.Set("order_price_type", string(o.OrderPriceType))
+
+	if !o.Price.IsZero() {
+		payload.Set("price", FormatPrice(o.Price, o.PriceTick))
+	}
+
+	return payload
+}
+
+// FormatPrice returns price rounded to nearest multiple of contract price tick and
+// formatted with tick precision for request payload, zero tick keeps price as is
+func FormatPrice(price, tick decimal.Decimal) json.Number {
+	if !tick.IsPositive() {
+		return json.Number(price.String())
+	}
+
+	price = price.Div(tick).Round(0).Mul(tick)
+	if tick.Exponent() < 0 {
+		return json.Number(price.StringFixed(-tick.Exponent()))
+	}
+	return json.Number(price.StringFixed(0))
+}
@@ -0,0 +1,129 @@
+package hbdm_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/andskur/hbdm-go"
+	"github.com/andskur/hbdm-go/hbdmtest"
+)
+
+func testOrder() hbdm.OrderRequest {
+	return hbdm.OrderRequest{
+		Symbol:         "BTC",
+		ContractType:   hbdm.ContractQuarter,
+		Price:          decimal.RequireFromString("9500"),
+		Volume:         1,
+		Direction:      hbdm.DirectionBuy,
+		Offset:         hbdm.OffsetOpen,
+		LeverRate:      10,
+		OrderPriceType: hbdm.PriceTypeLimit,
+	}
+}
+
+func TestFormatPrice(t *testing.T) {
+	tests := []struct {
+		price, tick, want string
+	}{
+		{"9500.123", "0.01", "9500.12"},
+		{"9500.125", "0.01", "9500.13"},
+		{"9500", "0.01", "9500.00"},
+		{"7.3", "0.5", "7.5"},
+		{"123.4", "1", "123"},
+		{"0.00000012", "0", "0.00000012"},
+	}
+
+	for _, tt := range tests {
+		got := hbdm.FormatPrice(decimal.RequireFromString(tt.price), decimal.RequireFromString(tt.tick))
+		if string(got) != tt.want {
+			t.Errorf("FormatPrice(%s, %s) = %s, want %s", tt.price, tt.tick, got, tt.want)
+		}
+	}
+}
+
+func TestPlaceOrderPayload(t *testing.T) {
+	srv := hbdmtest.NewServer("key", "secret")
+	defer srv.Close()
+
+	h := hbdm.New("key", "secret",
+		hbdm.WithEndpoints(srv.Endpoints()),
+		hbdm.WithNonceStore(hbdm.NewMemoryNonceStore(41)),
+	)
+
+	order := testOrder()
+	order.Price = decimal.RequireFromString("9500.123")
+	order.PriceTick = decimal.RequireFromString("0.01")
+
+	resp, err := h.PlaceOrder(order)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Data.OrderId != 733264528417345536 {
+		t.Errorf("order id = %d, want 733264528417345536", resp.Data.OrderId)
+	}
+
+	requests := srv.Requests()
+	if len(requests) != 1 {
+		t.Fatalf("got %d requests, want 1", len(requests))
+	}
+
+	var payload map[string]json.RawMessage
+	if err := requests[0].Params(&payload); err != nil {
+		t.Fatal(err)
+	}
+	if price := string(payload["price"]); price != "9500.12" {
+		t.Errorf("price = %s, want 9500.12", price)
+	}
+	if id := string(payload["client_order_id"]); id != "42" {
+		t.Errorf("client_order_id = %s, want 42", id)
+	}
+}
+
+func TestLightningCloseValidate(t *testing.T) {
+	srv := hbdmtest.NewServer("key", "secret")
+	defer srv.Close()
+
+	h := hbdm.New("key", "secret",
+		hbdm.WithEndpoints(srv.Endpoints()),
+		hbdm.WithNonceStore(hbdm.NewMemoryNonceStore(0)),
+	)
+
+	if _, err := h.LightningClose("", "", "", 1, hbdm.DirectionSell, ""); !errors.Is(err, hbdm.ErrInvalidOrder) {
+		t.Errorf("got error %v, want %v", err, hbdm.ErrInvalidOrder)
+	}
+	if _, err := h.LightningClose("BTC", "month", "", 1, hbdm.DirectionSell, ""); !errors.Is(err, hbdm.ErrInvalidOrder) {
+		t.Errorf("got error %v, want %v", err, hbdm.ErrInvalidOrder)
+	}
+	if n := len(srv.Requests()); n != 0 {
+		t.Errorf("got %d requests of invalid lightning close, want 0", n)
+	}
+}
+
+func TestSwapOrderRequest(t *testing.T) {
+	order := hbdm.SwapOrderRequest{
+		Price:          decimal.RequireFromString("9500.123"),
+		PriceTick:      decimal.RequireFromString("0.1"),
+		Volume:         1,
+		Direction:      hbdm.DirectionBuy,
+		Offset:         hbdm.OffsetOpen,
+		LeverRate:      10,
+		OrderPriceType: hbdm.PriceTypeLimit,
+	}
+
+	if err := order.Validate(); !errors.Is(err, hbdm.ErrInvalidOrder) {
+		t.Errorf("order without contract code: got error %v, want %v", err, hbdm.ErrInvalidOrder)
+	}
+
+	order.ContractCode = "BTC-USD"
+	if err := order.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	params := order.Params()
+	if params["contract_code"] != "BTC-USD" || params["price"] != json.Number("9500.1") {
+		t.Errorf("unexpected params %v", params)
+	}
+}
@@ -0,0 +1,83 @@
+package hbdm
+
+import (
+	"context"
+)
+
+// Pagination is pagination info of paged methods response data
+type Pagination struct {
+	TotalPage   int `json:"total_page"`
+	CurrentPage int `json:"current_page"`
+	TotalSize   int `json:"total_size"`
+}
+
+// PageOptions configures iteration over pages of paged methods
+type PageOptions struct {
+	PageSize int // items per page, API default if zero
+	MaxPages int // maximum number of fetched pages, all pages if zero
+}
+
+// pageFetcher loads page with given index, stores its items and returns items number
+type pageFetcher func(ctx context.Context, pageIndex, pageSize *int) (items int, page Pagination, err error)
+
+// pager walks over items of paged method, fetching pages by demand
+type pager struct {
+	ctx   context.Context
+	opts  PageOptions
+	fetch pageFetcher
+	page  int // index of last fetched page
+	items int // items number on last fetched page
+	pos   int // position of current item on last fetched page
+	done  bool
+	err   error
+}
+
+// next advances to next item, fetching next page if needed
+func (p *pager) next() bool {
+	if p.err != nil {
+		return false
+	}
+
+	if p.page > 0 && p.pos+1 < p.items {
+		p.pos++
+		return true
+	}
+
+	for !p.done {
+		if p.opts.MaxPages > 0 && p.page >= p.opts.MaxPages {
+			p.done = true
+			break
+		}
+
+		pageIndex := p.page + 1
+		var pageSize *int
+		if p.opts.PageSize > 0 {
+			pageSize = &p.opts.PageSize
+		}
+
+		items, page, err := p.fetch(p.ctx, &pageIndex, pageSize)
+		if err != nil {
+			p.err = err
+			return false
+		}
+
+		p.page = pageIndex
+		p.items = items
+		p.pos = 0
+
+		if items == 0 || page.TotalPage <= pageIndex {
+			p.done = true
+		}
+
+		if items > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Err returns error occurred while fetching pages
+func (p *pager) Err() error {
+	return p.err
+}
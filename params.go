@@ -0,0 +1,120 @@
+package hbdm
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+// Params is API request parameters, values may be strings, integers, floats, decimals, bools
+// or slices of them. Params are encoded to GET query string or POST JSON body, floats and
+// decimals are encoded as numbers without scientific notation
+type Params map[string]interface{}
+
+// Set sets parameter value and returns params for chaining, use it to build request payloads
+func (p Params) Set(key string, value interface{}) Params {
+	p[key] = value
+	return p
+}
+
+// Query returns params encoded as query values, slices are joined by comma
+func (p Params) Query() url.Values {
+	q := make(url.Values, len(p))
+	for key, value := range p {
+		q.Set(key, formatParam(reflect.ValueOf(value)))
+	}
+	return q
+}
+
+// MarshalJSON encodes params to JSON object with floats without scientific notation
+func (p Params) MarshalJSON() ([]byte, error) {
+	m := make(map[string]interface{}, len(p))
+	for key, value := range p {
+		m[key] = jsonParam(reflect.ValueOf(value))
+	}
+	return json.Marshal(m)
+}
+
+// formatParam returns query string representation of parameter value
+func formatParam(v reflect.Value) string {
+	switch v.Kind() {
+	case reflect.Invalid:
+		return ""
+	case reflect.String:
+		return v.String()
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10)
+	case reflect.Float32:
+		return strconv.FormatFloat(v.Float(), 'f', -1, 32)
+	case reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'f', -1, 64)
+	case reflect.Slice, reflect.Array:
+		values := make([]string, v.Len())
+		for i := range values {
+			values[i] = formatParam(v.Index(i))
+		}
+		return strings.Join(values, ",")
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return ""
+		}
+		return formatParam(v.Elem())
+	}
+
+	if s, ok := v.Interface().(fmt.Stringer); ok {
+		return s.String()
+	}
+	return fmt.Sprint(v.Interface())
+}
+
+// jsonParam returns parameter value with floats replaced by plain notation JSON numbers
+func jsonParam(v reflect.Value) interface{} {
+	switch v.Kind() {
+	case reflect.Invalid:
+		return nil
+	case reflect.Float32, reflect.Float64:
+		return json.Number(formatParam(v))
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && v.IsNil() {
+			return nil
+		}
+		values := make([]interface{}, v.Len())
+		for i := range values {
+			values[i] = jsonParam(v.Index(i))
+		}
+		return values
+	case reflect.Map:
+		if v.IsNil() || v.Type().Key().Kind() != reflect.String {
+			break
+		}
+		values := make(map[string]interface{}, v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			values[iter.Key().String()] = jsonParam(iter.Value())
+		}
+		return values
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		// keep pointers to other types as is for their JSON marshalers
+		switch v.Elem().Kind() {
+		case reflect.Float32, reflect.Float64, reflect.Slice, reflect.Array, reflect.Interface:
+			return jsonParam(v.Elem())
+		}
+	}
+
+	if d, ok := v.Interface().(decimal.Decimal); ok {
+		return json.Number(d.String())
+	}
+	return v.Interface()
+}
@@ -0,0 +1,95 @@
+package hbdm_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/andskur/hbdm-go"
+	"github.com/andskur/hbdm-go/hbdmtest"
+)
+
+func TestParamsQuery(t *testing.T) {
+	params := hbdm.Params{}.
+		Set("size", 150).
+		Set("page_index", int64(2)).
+		Set("price", 0.0000001).
+		Set("amount", decimal.RequireFromString("1.50")).
+		Set("ok", true).
+		Set("ids", []int64{1, 2}).
+		Set("direction", hbdm.DirectionBuy)
+
+	want := "amount=1.5&direction=buy&ids=1%2C2&ok=true&page_index=2&price=0.0000001&size=150"
+	if got := params.Query().Encode(); got != want {
+		t.Errorf("Query() = %s, want %s", got, want)
+	}
+}
+
+func TestParamsMarshalJSON(t *testing.T) {
+	params := hbdm.Params{
+		"price":  1e-7,
+		"big":    1e21,
+		"amount": decimal.RequireFromString("2.5"),
+		"nested": []map[string]interface{}{{"price": 1e-7}},
+		"orders": []hbdm.Params{{"price": 0.00000012}},
+	}
+
+	got, err := json.Marshal(params)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `{"amount":2.5,"big":1000000000000000000000,"nested":[{"price":0.0000001}],"orders":[{"price":0.00000012}],"price":0.0000001}`
+	if string(got) != want {
+		t.Errorf("MarshalJSON() = %s, want %s", got, want)
+	}
+}
+
+// TestGetNonStringParams is regression test of GET requests with integer parameters,
+// they were panicking on string type assertion
+func TestGetNonStringParams(t *testing.T) {
+	srv := hbdmtest.NewServer("key", "secret")
+	defer srv.Close()
+	srv.Handle("/market/history/kline", http.StatusOK, `{"status":"ok","ch":"market.BTC_CQ.kline.1min","data":[],"ts":1}`)
+
+	h := hbdm.New("key", "secret", hbdm.WithEndpoints(srv.Endpoints()))
+	if _, err := h.Kline("BTC_CQ", "1min", 150); err != nil {
+		t.Fatal(err)
+	}
+
+	requests := srv.Requests()
+	if len(requests) != 1 {
+		t.Fatalf("got %d requests, want 1", len(requests))
+	}
+	if size := requests[0].Query.Get("size"); size != "150" {
+		t.Errorf("size = %q, want 150", size)
+	}
+	if len(requests[0].Body) != 0 {
+		t.Errorf("GET request body = %q, want empty", requests[0].Body)
+	}
+}
+
+func TestSignedGetParams(t *testing.T) {
+	srv := hbdmtest.NewServer("key", "secret")
+	defer srv.Close()
+	srv.HandlePrivate("/v1/account/accounts", http.StatusOK, `{"status":"ok","ts":1}`)
+
+	h := hbdm.New("key", "secret", hbdm.WithEndpoints(srv.Endpoints()))
+
+	var resp hbdm.Response
+	params := hbdm.Params{}.Set("size", 150).Set("symbol", "BTC")
+	if err := h.Call(context.Background(), "GET", "/v1/account/accounts", params, true, true, &resp); err != nil {
+		t.Fatal(err)
+	}
+
+	requests := srv.Requests()
+	if len(requests) != 1 || !requests[0].Signed {
+		t.Fatalf("got requests %+v, want one signed", requests)
+	}
+	if size := requests[0].Query.Get("size"); size != "150" {
+		t.Errorf("size = %q, want 150", size)
+	}
+}
@@ -0,0 +1,229 @@
+package hbdm
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// RateLimitClass is class of API requests sharing one rate limit
+type RateLimitClass int
+
+// Rate limit classes
+const (
+	RateLimitPublic RateLimitClass = iota // market data requests, limited per IP
+	RateLimitTrade                        // private order placement, cancellation and transfer requests, limited per UID
+	RateLimitQuery                        // private account and orders query requests, limited per UID
+)
+
+// tradeResourceSuffixes are endpoint name suffixes of private trade requests
+var tradeResourceSuffixes = []string{"_order", "_batchorder", "_cancel", "_cancelall", "_close_position", "transfer", "_transfer_inner"}
+
+// rateLimitClass returns rate limit class of request to given API resource
+func rateLimitClass(resource string, authNeeded bool) RateLimitClass {
+	if !authNeeded {
+		return RateLimitPublic
+	}
+
+	name := resource[strings.LastIndex(resource, "/")+1:]
+	for _, suffix := range tradeResourceSuffixes {
+		if strings.HasSuffix(name, suffix) {
+			return RateLimitTrade
+		}
+	}
+
+	return RateLimitQuery
+}
+
+// RateLimits is number of requests allowed per interval for each rate limit class
+type RateLimits struct {
+	Public   int
+	Trade    int
+	Query    int
+	Interval time.Duration
+}
+
+// DefaultRateLimits is hbdm API documented rate limits
+var DefaultRateLimits = RateLimits{
+	Public:   60,
+	Trade:    30,
+	Query:    30,
+	Interval: 3 * time.Second,
+}
+
+// RateLimiter is token bucket rate limiter of API requests, hbdm limits each API product
+// separately, so buckets are kept per API host and path prefix and per class. Buckets are
+// tuned by ratelimit-limit, ratelimit-interval and ratelimit-remaining response headers of
+// their API, so requests of other clients using the same API key are taken into account.
+// Share one RateLimiter between Hbdm clients of one API key with WithRateLimiter option
+type RateLimiter struct {
+	limits RateLimits
+
+	mu      sync.Mutex
+	buckets map[rateLimitKey]*bucket
+}
+
+// rateLimitKey identifies bucket of API and rate limit class
+type rateLimitKey struct {
+	api   string
+	class RateLimitClass
+}
+
+// NewRateLimiter returns rate limiter with given initial limits of each API
+func NewRateLimiter(limits RateLimits) *RateLimiter {
+	return &RateLimiter{
+		limits:  limits,
+		buckets: make(map[rateLimitKey]*bucket),
+	}
+}
+
+// RateLimitAPI returns API of given request url used as rate limiter key, it's url host
+// with first path segment like "api.hbdm.com/swap-api"
+func RateLimitAPI(rawurl string) string {
+	u, err := url.Parse(rawurl)
+	if err != nil {
+		return rawurl
+	}
+
+	path := strings.TrimPrefix(u.Path, "/")
+	if i := strings.Index(path, "/"); i >= 0 {
+		path = path[:i]
+	}
+
+	return u.Host + "/" + path
+}
+
+// bucket returns bucket of given API and class, it's created with initial limits on first use
+func (l *RateLimiter) bucket(api string, class RateLimitClass) *bucket {
+	key := rateLimitKey{api: api, class: class}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	b, ok := l.buckets[key]
+	if ok {
+		return b
+	}
+
+	switch class {
+	case RateLimitPublic:
+		b = newBucket(l.limits.Public, l.limits.Interval)
+	case RateLimitTrade:
+		b = newBucket(l.limits.Trade, l.limits.Interval)
+	default:
+		b = newBucket(l.limits.Query, l.limits.Interval)
+	}
+	l.buckets[key] = b
+	return b
+}
+
+// Wait blocks until request of given class to given API is allowed or context is done,
+// api is RateLimitAPI of request url
+func (l *RateLimiter) Wait(ctx context.Context, api string, class RateLimitClass) error {
+	return l.bucket(api, class).wait(ctx)
+}
+
+// update tunes bucket of given API and class by rate limit headers of its response
+func (l *RateLimiter) update(api string, class RateLimitClass, header http.Header) {
+	limit := headerInt(header, "ratelimit-limit")
+	remaining := headerInt(header, "ratelimit-remaining")
+
+	var interval time.Duration
+	if ms := headerInt(header, "ratelimit-interval"); ms > 0 {
+		interval = time.Duration(ms) * time.Millisecond
+	}
+
+	l.bucket(api, class).tune(limit, remaining, interval)
+}
+
+// headerInt returns integer value of given header or -1 if it's missed or malformed
+func headerInt(header http.Header, key string) int {
+	value, err := strconv.Atoi(header.Get(key))
+	if err != nil {
+		return -1
+	}
+	return value
+}
+
+// bucket is token bucket refilled by capacity tokens per interval
+type bucket struct {
+	mu       sync.Mutex
+	capacity float64
+	tokens   float64
+	interval time.Duration
+	last     time.Time
+}
+
+// newBucket returns full bucket, zero capacity or interval bucket doesn't limit requests
+func newBucket(capacity int, interval time.Duration) *bucket {
+	return &bucket{
+		capacity: float64(capacity),
+		tokens:   float64(capacity),
+		interval: interval,
+		last:     time.Now(),
+	}
+}
+
+// refill adds tokens accumulated since last refill
+func (b *bucket) refill(now time.Time) {
+	b.tokens += float64(now.Sub(b.last)) * b.capacity / float64(b.interval)
+	if b.tokens > b.capacity {
+		b.tokens = b.capacity
+	}
+	b.last = now
+}
+
+// wait takes token from bucket, blocks until token is available or context is done
+func (b *bucket) wait(ctx context.Context) error {
+	for {
+		b.mu.Lock()
+		if b.capacity <= 0 || b.interval <= 0 {
+			b.mu.Unlock()
+			return nil
+		}
+
+		b.refill(time.Now())
+		if b.tokens >= 1 {
+			b.tokens--
+			b.mu.Unlock()
+			return nil
+		}
+
+		delay := time.Duration((1 - b.tokens) * float64(b.interval) / b.capacity)
+		b.mu.Unlock()
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// tune sets bucket capacity, interval and tokens left reported by API, negative or zero values are ignored
+func (b *bucket) tune(limit, remaining int, interval time.Duration) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.capacity <= 0 || b.interval <= 0 {
+		return
+	}
+
+	b.refill(time.Now())
+
+	if limit > 0 {
+		b.capacity = float64(limit)
+	}
+	if interval > 0 {
+		b.interval = interval
+	}
+	if remaining >= 0 && float64(remaining) < b.tokens {
+		b.tokens = float64(remaining)
+	}
+}
@@ -0,0 +1,91 @@
+package hbdm
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"net"
+	"net/http"
+	"reflect"
+	"time"
+)
+
+// RetryPolicy is retry and backoff configuration of transient API failures. Idempotent
+// requests are retried automatically, orders are retried by PlaceOrder only if
+// client order id was supplied by caller
+type RetryPolicy struct {
+	// MaxAttempts is maximum number of attempts including the first one, retries are disabled if it's 1 or less
+	MaxAttempts int
+	// MinBackoff is delay before first retry, it doubles with each next retry
+	MinBackoff time.Duration
+	// MaxBackoff is maximum delay between retries
+	MaxBackoff time.Duration
+}
+
+// DefaultRetryPolicy is retry policy used by default
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 3,
+	MinBackoff:  200 * time.Millisecond,
+	MaxBackoff:  2 * time.Second,
+}
+
+// retry reports whether request failed with given error at given attempt should be retried
+func (p RetryPolicy) retry(ctx context.Context, attempt int, err error) bool {
+	return err != nil && attempt < p.MaxAttempts && ctx.Err() == nil && IsTransient(err)
+}
+
+// backoff returns delay before retry after given attempt, delay is jittered by up to a half
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	delay := p.MinBackoff
+	for i := 1; i < attempt && delay < p.MaxBackoff; i++ {
+		delay *= 2
+	}
+	if p.MaxBackoff > 0 && delay > p.MaxBackoff {
+		delay = p.MaxBackoff
+	}
+	if delay <= 0 {
+		return 0
+	}
+	return delay/2 + time.Duration(rand.Int63n(int64(delay/2)+1))
+}
+
+// wait sleeps backoff delay after given attempt or until context is done
+func (p RetryPolicy) wait(ctx context.Context, attempt int) error {
+	timer := time.NewTimer(p.backoff(attempt))
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// IsTransient reports whether error is temporary API failure worth retrying:
+// 5xx HTTP status, system busy or maintenance codes and network timeouts
+func IsTransient(err error) bool {
+	if errors.Is(err, ErrSystemMaintenance) {
+		return true
+	}
+
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.HTTPStatus >= http.StatusInternalServerError
+	}
+
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+// resetResult sets typed result to zero value before decoding retried response
+func resetResult(result Enveloper) {
+	v := reflect.ValueOf(result)
+	if v.Kind() == reflect.Ptr && !v.IsNil() {
+		v.Elem().Set(reflect.Zero(v.Elem().Type()))
+	}
+}
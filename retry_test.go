@@ -0,0 +1,193 @@
+package hbdm_test
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/andskur/hbdm-go"
+	"github.com/andskur/hbdm-go/hbdmtest"
+)
+
+var testRetryPolicy = hbdm.RetryPolicy{MaxAttempts: 3, MinBackoff: time.Millisecond, MaxBackoff: time.Millisecond}
+
+// countRequests returns number of requests to given path
+func countRequests(srv *hbdmtest.Server, path string) (n int) {
+	for _, r := range srv.Requests() {
+		if r.Path == path {
+			n++
+		}
+	}
+	return
+}
+
+func TestRetryRead(t *testing.T) {
+	srv := hbdmtest.NewServer("key", "secret")
+	defer srv.Close()
+	srv.Handle("/api/v1/contract_account_info", http.StatusServiceUnavailable, "")
+
+	h := hbdm.New("key", "secret", hbdm.WithEndpoints(srv.Endpoints()), hbdm.WithRetryPolicy(testRetryPolicy))
+
+	if _, err := h.AccountInfo("BTC"); !errors.Is(err, hbdm.ErrSystemMaintenance) {
+		t.Errorf("got error %v, want %v", err, hbdm.ErrSystemMaintenance)
+	}
+	if n := countRequests(srv, "/api/v1/contract_account_info"); n != testRetryPolicy.MaxAttempts {
+		t.Errorf("got %d attempts, want %d", n, testRetryPolicy.MaxAttempts)
+	}
+}
+
+func TestNoRetryOrderWithoutClientOrderId(t *testing.T) {
+	srv := hbdmtest.NewServer("key", "secret")
+	defer srv.Close()
+	srv.Handle("/api/v1/contract_order", http.StatusServiceUnavailable, "")
+
+	h := hbdm.New("key", "secret",
+		hbdm.WithEndpoints(srv.Endpoints()),
+		hbdm.WithRetryPolicy(testRetryPolicy),
+		hbdm.WithNonceStore(hbdm.NewMemoryNonceStore(0)),
+	)
+
+	if _, err := h.PlaceOrder(testOrder()); err == nil {
+		t.Fatal("got nil error")
+	}
+	if n := countRequests(srv, "/api/v1/contract_order"); n != 1 {
+		t.Errorf("got %d attempts, want 1", n)
+	}
+}
+
+func TestRetryOrderChecksOrderInfo(t *testing.T) {
+	srv := hbdmtest.NewServer("key", "secret")
+	defer srv.Close()
+	srv.Handle("/api/v1/contract_order", http.StatusServiceUnavailable, "")
+
+	h := hbdm.New("key", "secret", hbdm.WithEndpoints(srv.Endpoints()), hbdm.WithRetryPolicy(testRetryPolicy))
+
+	order := testOrder()
+	order.ClientOrderId = 1
+
+	// default order info response has order with client order id 1
+	resp, err := h.PlaceOrder(order)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Data.ClientOrderId != order.ClientOrderId || resp.Data.OrderId != 733264528417345536 {
+		t.Errorf("unexpected order %+v", resp.Data)
+	}
+	if n := countRequests(srv, "/api/v1/contract_order"); n != 1 {
+		t.Errorf("got %d order attempts, want 1", n)
+	}
+
+	// order isn't found, so it's placed again
+	srv.HandleError("/api/v1/contract_order_info", 1071, "order doesn't exist")
+	if _, err := h.PlaceOrder(order); !errors.Is(err, hbdm.ErrSystemMaintenance) {
+		t.Errorf("got error %v, want %v", err, hbdm.ErrSystemMaintenance)
+	}
+	if n := countRequests(srv, "/api/v1/contract_order"); n != 1+testRetryPolicy.MaxAttempts {
+		t.Errorf("got %d order attempts, want %d", n, 1+testRetryPolicy.MaxAttempts)
+	}
+}
+
+// scriptedResponse replaces mock server response
+type scriptedResponse struct {
+	status int
+	body   string
+}
+
+// scriptedTransport passes requests to mock server and replaces its responses by scripted
+// ones of request path in order, so server still verifies and records requests
+type scriptedTransport struct {
+	mu        sync.Mutex
+	responses map[string][]scriptedResponse
+}
+
+func (t *scriptedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := http.DefaultTransport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	scripted := t.responses[req.URL.Path]
+	if len(scripted) == 0 {
+		return resp, nil
+	}
+	t.responses[req.URL.Path] = scripted[1:]
+
+	resp.Body.Close()
+	resp.StatusCode = scripted[0].status
+	resp.Body = ioutil.NopCloser(strings.NewReader(scripted[0].body))
+	return resp, nil
+}
+
+func TestRetryOrderDuplicate(t *testing.T) {
+	srv := hbdmtest.NewServer("key", "secret")
+	defer srv.Close()
+
+	transport := &scriptedTransport{responses: map[string][]scriptedResponse{
+		// first attempt result is unknown and order isn't found by order info yet
+		"/api/v1/contract_order": {
+			{http.StatusServiceUnavailable, ""},
+			{http.StatusOK, `{"status":"error","err_code":1050,"err_msg":"Customer's order number is repeated","ts":1}`},
+		},
+		"/api/v1/contract_order_info": {
+			{http.StatusOK, `{"status":"error","err_code":1071,"err_msg":"order doesn't exist","ts":1}`},
+		},
+	}}
+
+	h := hbdm.New("key", "secret",
+		hbdm.WithEndpoints(srv.Endpoints()),
+		hbdm.WithRetryPolicy(testRetryPolicy),
+		hbdm.WithHttpClient(&http.Client{Transport: transport}),
+	)
+
+	order := testOrder()
+	order.ClientOrderId = 1
+
+	resp, err := h.PlaceOrder(order)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Data.ClientOrderId != order.ClientOrderId || resp.Data.OrderId != 733264528417345536 {
+		t.Errorf("unexpected order %+v", resp.Data)
+	}
+	if n := countRequests(srv, "/api/v1/contract_order"); n != 2 {
+		t.Errorf("got %d order attempts, want 2", n)
+	}
+	if n := countRequests(srv, "/api/v1/contract_order_info"); n != 2 {
+		t.Errorf("got %d order info requests, want 2", n)
+	}
+}
+
+func TestCallIdempotent(t *testing.T) {
+	srv := hbdmtest.NewServer("key", "secret")
+	defer srv.Close()
+	srv.Handle("/swap-api/v1/swap_account_info", http.StatusServiceUnavailable, "")
+
+	h := hbdm.New("key", "secret", hbdm.WithEndpoints(srv.Endpoints()), hbdm.WithRetryPolicy(testRetryPolicy))
+
+	for _, tt := range []struct {
+		idempotent bool
+		attempts   int
+	}{
+		{false, 1},
+		{true, testRetryPolicy.MaxAttempts},
+	} {
+		before := countRequests(srv, "/swap-api/v1/swap_account_info")
+
+		var resp hbdm.Response
+		err := h.Call(context.Background(), "POST", "/swap-api/v1/swap_account_info", hbdm.Params{}, true, tt.idempotent, &resp)
+		if !errors.Is(err, hbdm.ErrSystemMaintenance) {
+			t.Errorf("idempotent %t: got error %v, want %v", tt.idempotent, err, hbdm.ErrSystemMaintenance)
+		}
+		if n := countRequests(srv, "/swap-api/v1/swap_account_info") - before; n != tt.attempts {
+			t.Errorf("idempotent %t: got %d attempts, want %d", tt.idempotent, n, tt.attempts)
+		}
+	}
+}
@@ -0,0 +1,173 @@
+package hbdm
+
+import (
+	"context"
+
+	"github.com/shopspring/decimal"
+)
+
+// SubAccountListResponse is response from SubAccountList method
+type SubAccountListResponse struct {
+	Response
+	Data []SubAccountListData `json:"data"`
+}
+
+// SubAccountListData is Sub-account with its accounts summary
+type SubAccountListData struct {
+	SubUid int64                   `json:"sub_uid"`
+	List   []SubAccountSummaryData `json:"list"`
+}
+
+// SubAccountSummaryData is Sub-account summary for one symbol
+type SubAccountSummaryData struct {
+	Symbol           string          `json:"symbol"`
+	MarginBalance    decimal.Decimal `json:"margin_balance"`
+	LiquidationPrice decimal.Decimal `json:"liquidation_price"`
+	RiskRate         decimal.Decimal `json:"risk_rate"`
+}
+
+// SubAccountList get summary of all sub-accounts, symbol is optional
+func (h *Hbdm) SubAccountList(symbol string) (list *SubAccountListResponse, err error) {
+	return h.SubAccountListCtx(context.Background(), symbol)
+}
+
+// SubAccountListCtx is SubAccountList with context for request cancellation and deadlines
+func (h *Hbdm) SubAccountListCtx(ctx context.Context, symbol string) (list *SubAccountListResponse, err error) {
+	payload := make(Params, 1)
+	if symbol != "" {
+		payload.Set("symbol", symbol)
+	}
+
+	list = new(SubAccountListResponse)
+	if err = h.client.call(ctx, "POST", "contract_sub_account_list", payload, true, true, list); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// SubAccountInfo return Sub-account Information, symbol is optional
+func (h *Hbdm) SubAccountInfo(symbol string, subUid int64) (info *AccountInfoResponse, err error) {
+	return h.SubAccountInfoCtx(context.Background(), symbol, subUid)
+}
+
+// SubAccountInfoCtx is SubAccountInfo with context for request cancellation and deadlines
+func (h *Hbdm) SubAccountInfoCtx(ctx context.Context, symbol string, subUid int64) (info *AccountInfoResponse, err error) {
+	payload := make(Params, 2)
+	payload.Set("sub_uid", subUid)
+	if symbol != "" {
+		payload.Set("symbol", symbol)
+	}
+
+	info = new(AccountInfoResponse)
+	if err = h.client.call(ctx, "POST", "contract_sub_account_info", payload, true, true, info); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// SubPositionInfo get Sub-account open positions, symbol is optional
+func (h *Hbdm) SubPositionInfo(symbol string, subUid int64) (positions *ContractPositionResponse, err error) {
+	return h.SubPositionInfoCtx(context.Background(), symbol, subUid)
+}
+
+// SubPositionInfoCtx is SubPositionInfo with context for request cancellation and deadlines
+func (h *Hbdm) SubPositionInfoCtx(ctx context.Context, symbol string, subUid int64) (positions *ContractPositionResponse, err error) {
+	payload := make(Params, 2)
+	payload.Set("sub_uid", subUid)
+	if symbol != "" {
+		payload.Set("symbol", symbol)
+	}
+
+	positions = new(ContractPositionResponse)
+	if err = h.client.call(ctx, "POST", "contract_sub_position_info", payload, true, true, positions); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// MasterSubTransferType is direction of transfer between master and sub-account
+type MasterSubTransferType string
+
+// Master and sub-account transfer types
+const (
+	MasterToSub MasterSubTransferType = "master_to_sub"
+	SubToMaster MasterSubTransferType = "sub_to_master"
+)
+
+// MasterSubTransferResponse is response from MasterSubTransfer method
+type MasterSubTransferResponse struct {
+	Response
+	Data struct {
+		OrderId string `json:"order_id"`
+	} `json:"data"`
+}
+
+// MasterSubTransfer transfer margin between master account and sub-account
+func (h *Hbdm) MasterSubTransfer(subUid int64, symbol string, amount decimal.Decimal, transferType MasterSubTransferType) (transfer *MasterSubTransferResponse, err error) {
+	return h.MasterSubTransferCtx(context.Background(), subUid, symbol, amount, transferType)
+}
+
+// MasterSubTransferCtx is MasterSubTransfer with context for request cancellation and deadlines
+func (h *Hbdm) MasterSubTransferCtx(ctx context.Context, subUid int64, symbol string, amount decimal.Decimal, transferType MasterSubTransferType) (transfer *MasterSubTransferResponse, err error) {
+	payload := make(Params, 4)
+	payload.Set("sub_uid", subUid)
+	payload.Set("symbol", symbol)
+	payload.Set("amount", amount)
+	payload.Set("type", string(transferType))
+
+	transfer = new(MasterSubTransferResponse)
+	if err = h.client.call(ctx, "POST", "contract_master_sub_transfer", payload, true, false, transfer); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// MasterSubTransferRecordResponse is response from MasterSubTransferRecord method
+type MasterSubTransferRecordResponse struct {
+	Response
+	Data struct {
+		TransferRecord []MasterSubTransferRecord `json:"transfer_record"`
+		Pagination
+	} `json:"data"`
+}
+
+// MasterSubTransferRecord is transfer between master and sub-account data model
+type MasterSubTransferRecord struct {
+	Id             int64           `json:"id"`
+	Ts             int64           `json:"ts"`
+	Symbol         string          `json:"symbol"`
+	SubUid         string          `json:"sub_uid"`
+	SubAccountName string          `json:"sub_account_name"`
+	TransferType   int             `json:"transfer_type"`
+	Amount         decimal.Decimal `json:"amount"`
+}
+
+// MasterSubTransferRecord get transfers between master and sub-accounts, transferType is
+// "34" for transfers to sub-account, "35" for transfers from sub-account or empty for all,
+// createDate is number of days
+func (h *Hbdm) MasterSubTransferRecord(symbol, transferType string, createDate int, pageIndex, pageSize *int) (records *MasterSubTransferRecordResponse, err error) {
+	return h.MasterSubTransferRecordCtx(context.Background(), symbol, transferType, createDate, pageIndex, pageSize)
+}
+
+// MasterSubTransferRecordCtx is MasterSubTransferRecord with context for request cancellation and deadlines
+func (h *Hbdm) MasterSubTransferRecordCtx(ctx context.Context, symbol, transferType string, createDate int, pageIndex, pageSize *int) (records *MasterSubTransferRecordResponse, err error) {
+	payload := make(Params, 5)
+	payload.Set("symbol", symbol)
+	payload.Set("create_date", createDate)
+
+	if transferType != "" {
+		payload.Set("transfer_type", transferType)
+	}
+	if pageIndex != nil {
+		payload.Set("page_index", *pageIndex)
+	}
+	if pageSize != nil {
+		payload.Set("page_size", *pageSize)
+	}
+
+	records = new(MasterSubTransferRecordResponse)
+	if err = h.client.call(ctx, "POST", "contract_master_sub_transfer_record", payload, true, true, records); err != nil {
+		return nil, err
+	}
+	return
+}
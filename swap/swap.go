@@ -0,0 +1,336 @@
+package swap
+
+import (
+	"context"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/andskur/hbdm-go"
+)
+
+// apiPath is path prefix of coin-margined swap API resources
+const apiPath = "/swap-api/v1/"
+
+// Client represent a hbdm coin-margined perpetual swap API client
+type Client struct {
+	hbdm *hbdm.Hbdm
+}
+
+// New returns an instantiated swap client configured with given hbdm options
+func New(apiKey, apiSecret string, opts ...hbdm.Option) *Client {
+	return NewWithHbdm(hbdm.New(apiKey, apiSecret, opts...))
+}
+
+// NewWithHbdm returns swap client sharing configuration, signing and nonce store with given hbdm client
+func NewWithHbdm(h *hbdm.Hbdm) *Client {
+	return &Client{hbdm: h}
+}
+
+// call process request to swap API method and decodes response into given result
+func (c *Client) call(ctx context.Context, method, resource string, payload hbdm.Params, authNeeded, idempotent bool, result hbdm.Enveloper) error {
+	return c.hbdm.Call(ctx, method, apiPath+resource, payload, authNeeded, idempotent, result)
+}
+
+// AccountInfoResponse is response for AccountInfo method
+type AccountInfoResponse struct {
+	hbdm.Response
+	Data []AccountInfoData `json:"data"`
+}
+
+// AccountInfoData is Swap account data model
+type AccountInfoData struct {
+	Symbol            string          `json:"symbol"`
+	ContractCode      string          `json:"contract_code"`
+	MarginBalance     decimal.Decimal `json:"margin_balance"`
+	MarginStatic      decimal.Decimal `json:"margin_static"`
+	MarginPosition    decimal.Decimal `json:"margin_position"`
+	MarginFrozen      decimal.Decimal `json:"margin_frozen"`
+	MarginAvailable   decimal.Decimal `json:"margin_available"`
+	ProfitReal        decimal.Decimal `json:"profit_real"`
+	ProfitUnreal      decimal.Decimal `json:"profit_unreal"`
+	WithdrawAvailable decimal.Decimal `json:"withdraw_available"`
+	RiskRate          decimal.Decimal `json:"risk_rate"`
+	LiquidationPrice  decimal.Decimal `json:"liquidation_price"`
+	AdjustFactor      decimal.Decimal `json:"adjust_factor"`
+	LeverRate         int             `json:"lever_rate"`
+}
+
+// AccountInfo return User’s swap Account Information, contract code like "BTC-USD" is optional
+func (c *Client) AccountInfo(contractCode string) (info *AccountInfoResponse, err error) {
+	return c.AccountInfoCtx(context.Background(), contractCode)
+}
+
+// AccountInfoCtx is AccountInfo with context for request cancellation and deadlines
+func (c *Client) AccountInfoCtx(ctx context.Context, contractCode string) (info *AccountInfoResponse, err error) {
+	payload := make(hbdm.Params, 1)
+	if contractCode != "" {
+		payload.Set("contract_code", contractCode)
+	}
+
+	info = new(AccountInfoResponse)
+	if err = c.call(ctx, "POST", "swap_account_info", payload, true, true, info); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// PositionInfoResponse is response from PositionInfo method
+type PositionInfoResponse struct {
+	hbdm.Response
+	Data []PositionData `json:"data"`
+}
+
+// PositionData is Swap position data model
+type PositionData struct {
+	Symbol         string          `json:"symbol"`
+	ContractCode   string          `json:"contract_code"`
+	Volume         decimal.Decimal `json:"volume"`
+	Available      decimal.Decimal `json:"available"`
+	Frozen         decimal.Decimal `json:"frozen"`
+	CostOpen       decimal.Decimal `json:"cost_open"`
+	CostHold       decimal.Decimal `json:"cost_hold"`
+	ProfitUnreal   decimal.Decimal `json:"profit_unreal"`
+	ProfitRate     decimal.Decimal `json:"profit_rate"`
+	Profit         decimal.Decimal `json:"profit"`
+	PositionMargin decimal.Decimal `json:"position_margin"`
+	LeverRate      int             `json:"lever_rate"`
+	Direction      string          `json:"direction"`
+	LastPrice      decimal.Decimal `json:"last_price"`
+}
+
+// PositionInfo get swap Account open positions, contract code is optional
+func (c *Client) PositionInfo(contractCode string) (positions *PositionInfoResponse, err error) {
+	return c.PositionInfoCtx(context.Background(), contractCode)
+}
+
+// PositionInfoCtx is PositionInfo with context for request cancellation and deadlines
+func (c *Client) PositionInfoCtx(ctx context.Context, contractCode string) (positions *PositionInfoResponse, err error) {
+	payload := make(hbdm.Params, 1)
+	if contractCode != "" {
+		payload.Set("contract_code", contractCode)
+	}
+
+	positions = new(PositionInfoResponse)
+	if err = c.call(ctx, "POST", "swap_position_info", payload, true, true, positions); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// OrderRequest is parameters of new swap order
+type OrderRequest = hbdm.SwapOrderRequest
+
+// OrderResponse is response from PlaceOrder method
+type OrderResponse struct {
+	hbdm.Response
+	Data struct {
+		OrderId       int64  `json:"order_id"`
+		OrderIdStr    string `json:"order_id_str"`
+		ClientOrderId int64  `json:"client_order_id"`
+	} `json:"data"`
+}
+
+// PlaceOrder validates and places swap order
+func (c *Client) PlaceOrder(order OrderRequest) (resp *OrderResponse, err error) {
+	return c.PlaceOrderCtx(context.Background(), order)
+}
+
+// PlaceOrderCtx is PlaceOrder with context for request cancellation and deadlines
+func (c *Client) PlaceOrderCtx(ctx context.Context, order OrderRequest) (resp *OrderResponse, err error) {
+	if err = order.Validate(); err != nil {
+		return
+	}
+
+	if order.ClientOrderId == 0 {
+		if order.ClientOrderId, err = c.hbdm.GetAndIncrementNonce(); err != nil {
+			return
+		}
+	}
+
+	resp = new(OrderResponse)
+	if err = c.call(ctx, "POST", "swap_order", order.Params(), true, false, resp); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// CancelResponse is response from Cancel and CancelAll methods
+type CancelResponse struct {
+	hbdm.Response
+	Data struct {
+		Errors    []hbdm.CancelOrderError `json:"errors"`
+		Successes string                  `json:"successes"`
+	} `json:"data"`
+}
+
+// Cancel cancel swap orders, orderIds and clientOrderIds are comma separated ID's, one of them is required
+func (c *Client) Cancel(contractCode, orderIds, clientOrderIds string) (resp *CancelResponse, err error) {
+	return c.CancelCtx(context.Background(), contractCode, orderIds, clientOrderIds)
+}
+
+// CancelCtx is Cancel with context for request cancellation and deadlines
+func (c *Client) CancelCtx(ctx context.Context, contractCode, orderIds, clientOrderIds string) (resp *CancelResponse, err error) {
+	payload := make(hbdm.Params, 3)
+	payload.Set("contract_code", contractCode)
+	if orderIds != "" {
+		payload.Set("order_id", orderIds)
+	}
+	if clientOrderIds != "" {
+		payload.Set("client_order_id", clientOrderIds)
+	}
+
+	resp = new(CancelResponse)
+	if err = c.call(ctx, "POST", "swap_cancel", payload, true, false, resp); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// CancelAll cancel all swap orders of given contract
+func (c *Client) CancelAll(contractCode string) (resp *CancelResponse, err error) {
+	return c.CancelAllCtx(context.Background(), contractCode)
+}
+
+// CancelAllCtx is CancelAll with context for request cancellation and deadlines
+func (c *Client) CancelAllCtx(ctx context.Context, contractCode string) (resp *CancelResponse, err error) {
+	payload := make(hbdm.Params, 1)
+	payload.Set("contract_code", contractCode)
+
+	resp = new(CancelResponse)
+	if err = c.call(ctx, "POST", "swap_cancelall", payload, true, false, resp); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// OrderInfoResponse is response for OrderInfo method
+type OrderInfoResponse struct {
+	hbdm.Response
+	Data []OrderInfoData `json:"data"`
+}
+
+// OrderInfoData is Swap order data model
+type OrderInfoData struct {
+	Symbol         string          `json:"symbol"`
+	ContractCode   string          `json:"contract_code"`
+	Volume         decimal.Decimal `json:"volume"`
+	Price          decimal.Decimal `json:"price"`
+	OrderPriceType string          `json:"order_price_type"`
+	OrderType      int             `json:"order_type"`
+	Direction      string          `json:"direction"`
+	Offset         string          `json:"offset"`
+	LeverRate      int             `json:"lever_rate"`
+	OrderId        int64           `json:"order_id"`
+	OrderIdStr     string          `json:"order_id_str"`
+	ClientOrderId  int64           `json:"client_order_id"`
+	OrderSource    string          `json:"order_source"`
+	CreatedAt      int64           `json:"created_at"`
+	TradeVolume    decimal.Decimal `json:"trade_volume"`
+	TradeTurnover  decimal.Decimal `json:"trade_turnover"`
+	Fee            decimal.Decimal `json:"fee"`
+	FeeAsset       string          `json:"fee_asset"`
+	TradeAvgPrice  decimal.Decimal `json:"trade_avg_price"`
+	MarginFrozen   decimal.Decimal `json:"margin_frozen"`
+	Profit         decimal.Decimal `json:"profit"`
+	Status         int             `json:"status"`
+}
+
+// OrderInfo get swap orders info, orderIds and clientOrderIds are comma separated ID's, one of them is required
+func (c *Client) OrderInfo(contractCode, orderIds, clientOrderIds string) (orders *OrderInfoResponse, err error) {
+	return c.OrderInfoCtx(context.Background(), contractCode, orderIds, clientOrderIds)
+}
+
+// OrderInfoCtx is OrderInfo with context for request cancellation and deadlines
+func (c *Client) OrderInfoCtx(ctx context.Context, contractCode, orderIds, clientOrderIds string) (orders *OrderInfoResponse, err error) {
+	payload := make(hbdm.Params, 3)
+	payload.Set("contract_code", contractCode)
+	if orderIds != "" {
+		payload.Set("order_id", orderIds)
+	}
+	if clientOrderIds != "" {
+		payload.Set("client_order_id", clientOrderIds)
+	}
+
+	orders = new(OrderInfoResponse)
+	if err = c.call(ctx, "POST", "swap_order_info", payload, true, true, orders); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// FundingRateResponse is response for FundingRate method
+type FundingRateResponse struct {
+	hbdm.Response
+	Data FundingRateData `json:"data"`
+}
+
+// FundingRateData is Swap funding rate data model
+type FundingRateData struct {
+	Symbol          string          `json:"symbol"`
+	ContractCode    string          `json:"contract_code"`
+	FeeAsset        string          `json:"fee_asset"`
+	FundingTime     int64           `json:"funding_time,string"`
+	FundingRate     decimal.Decimal `json:"funding_rate"`
+	EstimatedRate   decimal.Decimal `json:"estimated_rate"`
+	NextFundingTime int64           `json:"next_funding_time,string"`
+}
+
+// FundingRate get current funding rate of swap contract
+func (c *Client) FundingRate(contractCode string) (rate *FundingRateResponse, err error) {
+	return c.FundingRateCtx(context.Background(), contractCode)
+}
+
+// FundingRateCtx is FundingRate with context for request cancellation and deadlines
+func (c *Client) FundingRateCtx(ctx context.Context, contractCode string) (rate *FundingRateResponse, err error) {
+	payload := make(hbdm.Params, 1)
+	payload.Set("contract_code", contractCode)
+
+	rate = new(FundingRateResponse)
+	if err = c.call(ctx, "GET", "swap_funding_rate", payload, false, true, rate); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// HistoricalFundingRateResponse is response for HistoricalFundingRate method
+type HistoricalFundingRateResponse struct {
+	hbdm.Response
+	Data struct {
+		Data []HistoricalFundingRateData `json:"data"`
+		hbdm.Pagination
+	} `json:"data"`
+}
+
+// HistoricalFundingRateData is Swap settled funding rate data model
+type HistoricalFundingRateData struct {
+	Symbol       string          `json:"symbol"`
+	ContractCode string          `json:"contract_code"`
+	FeeAsset     string          `json:"fee_asset"`
+	FundingTime  int64           `json:"funding_time,string"`
+	FundingRate  decimal.Decimal `json:"funding_rate"`
+	RealizedRate decimal.Decimal `json:"realized_rate"`
+	AvgPremium   decimal.Decimal `json:"avg_premium_index"`
+}
+
+// HistoricalFundingRate get settled funding rates of swap contract
+func (c *Client) HistoricalFundingRate(contractCode string, pageIndex, pageSize *int) (rates *HistoricalFundingRateResponse, err error) {
+	return c.HistoricalFundingRateCtx(context.Background(), contractCode, pageIndex, pageSize)
+}
+
+// HistoricalFundingRateCtx is HistoricalFundingRate with context for request cancellation and deadlines
+func (c *Client) HistoricalFundingRateCtx(ctx context.Context, contractCode string, pageIndex, pageSize *int) (rates *HistoricalFundingRateResponse, err error) {
+	payload := make(hbdm.Params, 3)
+	payload.Set("contract_code", contractCode)
+	if pageIndex != nil {
+		payload.Set("page_index", *pageIndex)
+	}
+	if pageSize != nil {
+		payload.Set("page_size", *pageSize)
+	}
+
+	rates = new(HistoricalFundingRateResponse)
+	if err = c.call(ctx, "GET", "swap_historical_funding_rate", payload, false, true, rates); err != nil {
+		return nil, err
+	}
+	return
+}
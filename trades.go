@@ -0,0 +1,167 @@
+package hbdm
+
+import (
+	"context"
+
+	"github.com/shopspring/decimal"
+)
+
+// Trade roles
+const (
+	RoleMaker = "maker"
+	RoleTaker = "taker"
+)
+
+// OrderTrade is single fill of order
+type OrderTrade struct {
+	Id            string          `json:"id"`
+	TradeId       int64           `json:"trade_id"`
+	TradeVolume   decimal.Decimal `json:"trade_volume"`
+	TradePrice    decimal.Decimal `json:"trade_price"`
+	TradeFee      decimal.Decimal `json:"trade_fee"`
+	TradeTurnover decimal.Decimal `json:"trade_turnover"`
+	CreatedAt     int             `json:"created_at"`
+	Role          string          `json:"role"`
+	FeeAsset      string          `json:"fee_asset"`
+	RealProfit    decimal.Decimal `json:"real_profit"`
+}
+
+// MatchResultsResponse is response from MatchResults method
+type MatchResultsResponse struct {
+	Response
+	Data struct {
+		Trades []MatchResult `json:"trades"`
+		Pagination
+	} `json:"data"`
+}
+
+// MatchResult is executed trade data model
+type MatchResult struct {
+	Id               string          `json:"id"`
+	MatchId          int64           `json:"match_id"`
+	OrderId          int64           `json:"order_id"`
+	OrderIdStr       string          `json:"order_id_str"`
+	Symbol           string          `json:"symbol"`
+	ContractType     string          `json:"contract_type"`
+	ContractCode     string          `json:"contract_code"`
+	Direction        string          `json:"direction"`
+	Offset           string          `json:"offset"`
+	TradeVolume      decimal.Decimal `json:"trade_volume"`
+	TradePrice       decimal.Decimal `json:"trade_price"`
+	TradeTurnover    decimal.Decimal `json:"trade_turnover"`
+	TradeFee         decimal.Decimal `json:"trade_fee"`
+	OffsetProfitloss decimal.Decimal `json:"offset_profitloss"`
+	CreateDate       int             `json:"create_date"`
+	Role             string          `json:"role"`
+	FeeAsset         string          `json:"fee_asset"`
+}
+
+// OrderTrade returns match result as order fill
+func (m MatchResult) OrderTrade() OrderTrade {
+	return OrderTrade{
+		Id:            m.Id,
+		TradeId:       m.MatchId,
+		TradeVolume:   m.TradeVolume,
+		TradePrice:    m.TradePrice,
+		TradeFee:      m.TradeFee,
+		TradeTurnover: m.TradeTurnover,
+		CreatedAt:     m.CreateDate,
+		Role:          m.Role,
+		FeeAsset:      m.FeeAsset,
+		RealProfit:    m.OffsetProfitloss,
+	}
+}
+
+// MatchResults get executed trades by given filters, tradeType is 0 for all, createDate
+// is number of days, contract code is optional
+func (h *Hbdm) MatchResults(symbol string, tradeType, createDate int, contractCode string, pageIndex, pageSize *int) (trades *MatchResultsResponse, err error) {
+	return h.MatchResultsCtx(context.Background(), symbol, tradeType, createDate, contractCode, pageIndex, pageSize)
+}
+
+// MatchResultsCtx is MatchResults with context for request cancellation and deadlines
+func (h *Hbdm) MatchResultsCtx(ctx context.Context, symbol string, tradeType, createDate int, contractCode string, pageIndex, pageSize *int) (trades *MatchResultsResponse, err error) {
+	payload := make(Params, 6)
+	payload.Set("symbol", symbol)
+	payload.Set("trade_type", tradeType)
+	payload.Set("create_date", createDate)
+
+	if contractCode != "" {
+		payload.Set("contract_code", contractCode)
+	}
+	if pageIndex != nil {
+		payload.Set("page_index", *pageIndex)
+	}
+	if pageSize != nil {
+		payload.Set("page_size", *pageSize)
+	}
+
+	trades = new(MatchResultsResponse)
+	if err = h.client.call(ctx, "POST", "contract_matchresults", payload, true, true, trades); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// OrderDetailResponse is response from OrderDetail method
+type OrderDetailResponse struct {
+	Response
+	Data OrderDetailData `json:"data"`
+}
+
+// OrderDetailData is Order with fills data model
+type OrderDetailData struct {
+	Symbol          string          `json:"symbol"`
+	ContractType    string          `json:"contract_type"`
+	ContractCode    string          `json:"contract_code"`
+	LeverRate       int             `json:"lever_rate"`
+	Direction       string          `json:"direction"`
+	Offset          string          `json:"offset"`
+	Volume          decimal.Decimal `json:"volume"`
+	Price           decimal.Decimal `json:"price"`
+	CreatedAt       int             `json:"created_at"`
+	CanceledAt      int             `json:"canceled_at"`
+	OrderSource     string          `json:"order_source"`
+	OrderPriceType  string          `json:"order_price_type"`
+	MarginFrozen    decimal.Decimal `json:"margin_frozen"`
+	Profit          decimal.Decimal `json:"profit"`
+	InstrumentPrice decimal.Decimal `json:"instrument_price"`
+	FinalInterest   decimal.Decimal `json:"final_interest"`
+	AdjustValue     decimal.Decimal `json:"adjust_value"`
+	Fee             decimal.Decimal `json:"fee"`
+	FeeAsset        string          `json:"fee_asset"`
+	LiquidationType string          `json:"liquidation_type"`
+	Trades          []OrderTrade    `json:"trades"`
+	Pagination
+}
+
+// OrderDetail get order with its fills, createdAt is order creation timestamp,
+// orderType is 1 for order request, 2 for cancelled order, 3 for liquidation, 4 for delivery
+func (h *Hbdm) OrderDetail(symbol string, orderId int64, createdAt int64, orderType int, pageIndex, pageSize *int) (detail *OrderDetailResponse, err error) {
+	return h.OrderDetailCtx(context.Background(), symbol, orderId, createdAt, orderType, pageIndex, pageSize)
+}
+
+// OrderDetailCtx is OrderDetail with context for request cancellation and deadlines
+func (h *Hbdm) OrderDetailCtx(ctx context.Context, symbol string, orderId int64, createdAt int64, orderType int, pageIndex, pageSize *int) (detail *OrderDetailResponse, err error) {
+	payload := make(Params, 6)
+	payload.Set("symbol", symbol)
+	payload.Set("order_id", orderId)
+
+	if createdAt != 0 {
+		payload.Set("created_at", createdAt)
+	}
+	if orderType != 0 {
+		payload.Set("order_type", orderType)
+	}
+	if pageIndex != nil {
+		payload.Set("page_index", *pageIndex)
+	}
+	if pageSize != nil {
+		payload.Set("page_size", *pageSize)
+	}
+
+	detail = new(OrderDetailResponse)
+	if err = h.client.call(ctx, "POST", "contract_order_detail", payload, true, true, detail); err != nil {
+		return nil, err
+	}
+	return
+}
@@ -0,0 +1,66 @@
+package hbdm
+
+import (
+	"context"
+	"errors"
+
+	"github.com/shopspring/decimal"
+)
+
+// futuresTransferPath is Huobi spot API path of transfers between spot and futures accounts
+const futuresTransferPath = "/v1/futures/transfer"
+
+// ErrNoSpotEndpoint is returned by spot and futures accounts transfers when client
+// Endpoints have no Spot API url
+var ErrNoSpotEndpoint = errors.New("hbdm: spot API endpoint is not configured")
+
+// Spot and futures accounts transfer types
+const (
+	transferSpotToFutures = "pro-to-futures"
+	transferFuturesToSpot = "futures-to-pro"
+)
+
+// FuturesTransferResponse is response from TransferToFutures and TransferToSpot methods
+type FuturesTransferResponse struct {
+	Response
+	Data int64 `json:"data"` // transfer ID
+}
+
+// TransferToFutures transfer currency from spot to futures account, currency is lowercase like "btc"
+func (h *Hbdm) TransferToFutures(currency string, amount decimal.Decimal) (transfer *FuturesTransferResponse, err error) {
+	return h.TransferToFuturesCtx(context.Background(), currency, amount)
+}
+
+// TransferToFuturesCtx is TransferToFutures with context for request cancellation and deadlines
+func (h *Hbdm) TransferToFuturesCtx(ctx context.Context, currency string, amount decimal.Decimal) (transfer *FuturesTransferResponse, err error) {
+	return h.futuresTransfer(ctx, currency, amount, transferSpotToFutures)
+}
+
+// TransferToSpot transfer currency from futures to spot account, currency is lowercase like "btc"
+func (h *Hbdm) TransferToSpot(currency string, amount decimal.Decimal) (transfer *FuturesTransferResponse, err error) {
+	return h.TransferToSpotCtx(context.Background(), currency, amount)
+}
+
+// TransferToSpotCtx is TransferToSpot with context for request cancellation and deadlines
+func (h *Hbdm) TransferToSpotCtx(ctx context.Context, currency string, amount decimal.Decimal) (transfer *FuturesTransferResponse, err error) {
+	return h.futuresTransfer(ctx, currency, amount, transferFuturesToSpot)
+}
+
+// futuresTransfer transfer currency between spot and futures accounts via Huobi spot API
+func (h *Hbdm) futuresTransfer(ctx context.Context, currency string, amount decimal.Decimal, transferType string) (transfer *FuturesTransferResponse, err error) {
+	// empty spot url would send transfer to hbdm REST API host
+	if h.client.endpoints.Spot == "" {
+		return nil, ErrNoSpotEndpoint
+	}
+
+	payload := make(Params, 3)
+	payload.Set("currency", currency)
+	payload.Set("amount", amount)
+	payload.Set("type", transferType)
+
+	transfer = new(FuturesTransferResponse)
+	if err = h.client.call(ctx, "POST", h.client.spotURL(futuresTransferPath), payload, true, false, transfer); err != nil {
+		return nil, err
+	}
+	return
+}
@@ -0,0 +1,27 @@
+package hbdm_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/andskur/hbdm-go"
+	"github.com/andskur/hbdm-go/hbdmtest"
+)
+
+func TestTransferWithoutSpotEndpoint(t *testing.T) {
+	srv := hbdmtest.NewServer("key", "secret")
+	defer srv.Close()
+
+	endpoints := srv.Endpoints()
+	endpoints.Spot = ""
+	h := hbdm.New("key", "secret", hbdm.WithEndpoints(endpoints))
+
+	if _, err := h.TransferToSpot("btc", decimal.RequireFromString("0.1")); !errors.Is(err, hbdm.ErrNoSpotEndpoint) {
+		t.Errorf("got error %v, want %v", err, hbdm.ErrNoSpotEndpoint)
+	}
+	if n := len(srv.Requests()); n != 0 {
+		t.Errorf("got %d requests, want 0", n)
+	}
+}
@@ -0,0 +1,282 @@
+package hbdm
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+// TriggerType is trigger order condition relative to trigger price
+type TriggerType string
+
+// Trigger types
+const (
+	TriggerGreaterOrEqual TriggerType = "ge"
+	TriggerLessOrEqual    TriggerType = "le"
+)
+
+// Valid reports whether trigger type is known
+func (t TriggerType) Valid() bool {
+	return t == TriggerGreaterOrEqual || t == TriggerLessOrEqual
+}
+
+// TriggerOrderRequest is parameters of new trigger order, contract is set
+// by Symbol with ContractType or by ContractCode
+type TriggerOrderRequest struct {
+	Symbol         string
+	ContractType   ContractType
+	ContractCode   string
+	TriggerType    TriggerType
+	TriggerPrice   decimal.Decimal
+	OrderPrice     decimal.Decimal
+	PriceTick      decimal.Decimal // contract price tick, prices are sent rounded to it if set
+	OrderPriceType OrderPriceType  // limit or optimal_5/10/20, limit by default
+	Volume         int
+	Direction      Direction
+	Offset         Offset
+	LeverRate      int
+}
+
+// Validate checks trigger order parameters before sending
+func (o TriggerOrderRequest) Validate() error {
+	if o.ContractCode == "" {
+		if o.Symbol == "" {
+			return fmt.Errorf("%w: symbol or contract code is required", ErrInvalidOrder)
+		}
+		if !o.ContractType.Valid() {
+			return fmt.Errorf("%w: contract type %q", ErrInvalidOrder, o.ContractType)
+		}
+	}
+
+	if !o.TriggerType.Valid() {
+		return fmt.Errorf("%w: trigger type %q", ErrInvalidOrder, o.TriggerType)
+	}
+
+	if !o.TriggerPrice.IsPositive() {
+		return fmt.Errorf("%w: trigger price %v", ErrInvalidOrder, o.TriggerPrice)
+	}
+
+	switch o.OrderPriceType {
+	case "", PriceTypeLimit:
+		if !o.OrderPrice.IsPositive() {
+			return fmt.Errorf("%w: order price %v for limit order", ErrInvalidOrder, o.OrderPrice)
+		}
+	case PriceTypeOptimal5, PriceTypeOptimal10, PriceTypeOptimal20:
+	default:
+		return fmt.Errorf("%w: order price type %q for trigger order", ErrInvalidOrder, o.OrderPriceType)
+	}
+
+	if !o.Direction.Valid() {
+		return fmt.Errorf("%w: direction %q", ErrInvalidOrder, o.Direction)
+	}
+
+	if !o.Offset.Valid() {
+		return fmt.Errorf("%w: offset %q", ErrInvalidOrder, o.Offset)
+	}
+
+	if o.Volume <= 0 {
+		return fmt.Errorf("%w: volume %d", ErrInvalidOrder, o.Volume)
+	}
+
+	if o.LeverRate <= 0 {
+		return fmt.Errorf("%w: lever rate %d", ErrInvalidOrder, o.LeverRate)
+	}
+
+	return nil
+}
+
+// payload returns API request payload of trigger order
+func (o TriggerOrderRequest) payload() Params {
+	payload := make(Params, 11)
+	payload.Set("trigger_type", string(o.TriggerType))
+	payload.Set("trigger_price", FormatPrice(o.TriggerPrice, o.PriceTick))
+	payload.Set("volume", o.Volume)
+	payload.Set("direction", string(o.Direction))
+	payload.Set("offset", string(o.Offset))
+	payload.Set("lever_rate", o.LeverRate)
+
+	if o.Symbol != "" {
+		payload.Set("symbol", o.Symbol)
+	}
+	if o.ContractType != "" {
+		payload.Set("contract_type", string(o.ContractType))
+	}
+	if o.ContractCode != "" {
+		payload.Set("contract_code", o.ContractCode)
+	}
+	if !o.OrderPrice.IsZero() {
+		payload.Set("order_price", FormatPrice(o.OrderPrice, o.PriceTick))
+	}
+	if o.OrderPriceType != "" {
+		payload.Set("order_price_type", string(o.OrderPriceType))
+	}
+
+	return payload
+}
+
+// TriggerOrderResponse is response from TriggerOrder method
+type TriggerOrderResponse struct {
+	Response
+	Data struct {
+		OrderId    int64  `json:"order_id"`
+		OrderIdStr string `json:"order_id_str"`
+	} `json:"data"`
+}
+
+// TriggerOrder validates and places trigger order, order is placed by exchange when
+// last price reaches trigger price
+func (h *Hbdm) TriggerOrder(order TriggerOrderRequest) (resp *TriggerOrderResponse, err error) {
+	return h.TriggerOrderCtx(context.Background(), order)
+}
+
+// TriggerOrderCtx is TriggerOrder with context for request cancellation and deadlines
+func (h *Hbdm) TriggerOrderCtx(ctx context.Context, order TriggerOrderRequest) (resp *TriggerOrderResponse, err error) {
+	if err = order.Validate(); err != nil {
+		return
+	}
+
+	resp = new(TriggerOrderResponse)
+	if err = h.client.call(ctx, "POST", "contract_trigger_order", order.payload(), true, false, resp); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// TriggerCancelResponse is response from TriggerCancel and TriggerCancelAll methods
+type TriggerCancelResponse struct {
+	Response
+	Data struct {
+		Errors    []CancelOrderError `json:"errors"`
+		Successes string             `json:"successes"`
+	} `json:"data"`
+}
+
+// TriggerCancel cancel trigger orders with given ID's for given symbol
+func (h *Hbdm) TriggerCancel(symbol string, orderIds ...string) (resp *TriggerCancelResponse, err error) {
+	return h.TriggerCancelCtx(context.Background(), symbol, orderIds...)
+}
+
+// TriggerCancelCtx is TriggerCancel with context for request cancellation and deadlines
+func (h *Hbdm) TriggerCancelCtx(ctx context.Context, symbol string, orderIds ...string) (resp *TriggerCancelResponse, err error) {
+	payload := make(Params, 2)
+	payload.Set("symbol", symbol)
+	payload.Set("order_id", strings.Join(orderIds, ","))
+
+	resp = new(TriggerCancelResponse)
+	if err = h.client.call(ctx, "POST", "contract_trigger_cancel", payload, true, false, resp); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// TriggerCancelAll cancel all trigger orders for given symbol, contract filters are optional
+func (h *Hbdm) TriggerCancelAll(symbol, contractCode string, contractType ContractType) (resp *TriggerCancelResponse, err error) {
+	return h.TriggerCancelAllCtx(context.Background(), symbol, contractCode, contractType)
+}
+
+// TriggerCancelAllCtx is TriggerCancelAll with context for request cancellation and deadlines
+func (h *Hbdm) TriggerCancelAllCtx(ctx context.Context, symbol, contractCode string, contractType ContractType) (resp *TriggerCancelResponse, err error) {
+	payload := contractFilterPayload(symbol, string(contractType), contractCode)
+
+	resp = new(TriggerCancelResponse)
+	if err = h.client.call(ctx, "POST", "contract_trigger_cancelall", payload, true, false, resp); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// TriggerOrderData is Trigger order data model
+type TriggerOrderData struct {
+	Symbol         string          `json:"symbol"`
+	ContractType   string          `json:"contract_type"`
+	ContractCode   string          `json:"contract_code"`
+	TriggerType    string          `json:"trigger_type"`
+	Volume         decimal.Decimal `json:"volume"`
+	OrderType      int             `json:"order_type"`
+	Direction      string          `json:"direction"`
+	Offset         string          `json:"offset"`
+	LeverRate      int             `json:"lever_rate"`
+	OrderId        int64           `json:"order_id"`
+	OrderIdStr     string          `json:"order_id_str"`
+	OrderSource    string          `json:"order_source"`
+	TriggerPrice   decimal.Decimal `json:"trigger_price"`
+	OrderPrice     decimal.Decimal `json:"order_price"`
+	OrderPriceType string          `json:"order_price_type"`
+	CreatedAt      int64           `json:"created_at"`
+	Status         int             `json:"status"`
+
+	// history orders fields
+	TriggeredPrice  decimal.Decimal `json:"triggered_price"`
+	TriggeredAt     int64           `json:"triggered_at"`
+	RelationOrderId string          `json:"relation_order_id"`
+	FailCode        int             `json:"fail_code"`
+	FailReason      string          `json:"fail_reason"`
+}
+
+// TriggerOrdersResponse is mutual response for Trigger orders arrays methods - Open, History
+type TriggerOrdersResponse struct {
+	Response
+	Data struct {
+		Orders []TriggerOrderData `json:"orders"`
+		Pagination
+	} `json:"data"`
+}
+
+// TriggerOpenOrders get open trigger orders for given symbol, contract code is optional
+func (h *Hbdm) TriggerOpenOrders(symbol, contractCode string, pageIndex, pageSize *int) (orders *TriggerOrdersResponse, err error) {
+	return h.TriggerOpenOrdersCtx(context.Background(), symbol, contractCode, pageIndex, pageSize)
+}
+
+// TriggerOpenOrdersCtx is TriggerOpenOrders with context for request cancellation and deadlines
+func (h *Hbdm) TriggerOpenOrdersCtx(ctx context.Context, symbol, contractCode string, pageIndex, pageSize *int) (orders *TriggerOrdersResponse, err error) {
+	payload := make(Params, 4)
+	payload.Set("symbol", symbol)
+	if contractCode != "" {
+		payload.Set("contract_code", contractCode)
+	}
+	if pageIndex != nil {
+		payload.Set("page_index", *pageIndex)
+	}
+	if pageSize != nil {
+		payload.Set("page_size", *pageSize)
+	}
+
+	orders = new(TriggerOrdersResponse)
+	if err = h.client.call(ctx, "POST", "contract_trigger_openorders", payload, true, true, orders); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// TriggerHistoryOrders get history trigger orders by given filters, tradeType is 0 for all,
+// status is comma separated statuses or "0" for all, createDate is number of days
+func (h *Hbdm) TriggerHistoryOrders(symbol, contractCode string, tradeType int, status string, createDate int, pageIndex, pageSize *int) (orders *TriggerOrdersResponse, err error) {
+	return h.TriggerHistoryOrdersCtx(context.Background(), symbol, contractCode, tradeType, status, createDate, pageIndex, pageSize)
+}
+
+// TriggerHistoryOrdersCtx is TriggerHistoryOrders with context for request cancellation and deadlines
+func (h *Hbdm) TriggerHistoryOrdersCtx(ctx context.Context, symbol, contractCode string, tradeType int, status string, createDate int, pageIndex, pageSize *int) (orders *TriggerOrdersResponse, err error) {
+	payload := make(Params, 7)
+	payload.Set("symbol", symbol)
+	payload.Set("trade_type", tradeType)
+	payload.Set("status", status)
+	payload.Set("create_date", createDate)
+
+	if contractCode != "" {
+		payload.Set("contract_code", contractCode)
+	}
+	if pageIndex != nil {
+		payload.Set("page_index", *pageIndex)
+	}
+	if pageSize != nil {
+		payload.Set("page_size", *pageSize)
+	}
+
+	orders = new(TriggerOrdersResponse)
+	if err = h.client.call(ctx, "POST", "contract_trigger_hisorders", payload, true, true, orders); err != nil {
+		return nil, err
+	}
+	return
+}
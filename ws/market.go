@@ -7,12 +7,13 @@ import (
 	"errors"
 	"fmt"
 	"io/ioutil"
-	"log"
 	"strings"
 	"sync"
 
 	"github.com/gofrs/uuid"
 	"github.com/gorilla/websocket"
+
+	"github.com/andskur/hbdm-go"
 )
 
 var (
@@ -20,11 +21,6 @@ var (
 	wgM sync.WaitGroup
 )
 
-// HBDM websocket API URL's
-const (
-	wsMarketData = "wss://www.hbdm.com/ws"
-)
-
 // responseMarketChannels handles all incoming data from the hbdm connection.
 type responseMarketChannels struct {
 	MarketDepth map[string]chan WsDepthMarketResponse
@@ -36,12 +32,15 @@ type responseMarketChannels struct {
 type WSMarketClient struct {
 	conn    *websocket.Conn
 	Updates *responseMarketChannels
+	logger  hbdm.Logger
 	exit    chan struct{}
 }
 
 // NewWSMarketClient creates a new hbm Websocket API client
-func NewWSMarketClient() (*WSMarketClient, error) {
-	conn, _, err := websocket.DefaultDialer.Dial(wsMarketData, nil)
+func NewWSMarketClient(opts ...Option) (*WSMarketClient, error) {
+	cfg := newConfig(opts)
+
+	conn, _, err := websocket.DefaultDialer.Dial(cfg.endpoints.WSMarket, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -55,6 +54,7 @@ func NewWSMarketClient() (*WSMarketClient, error) {
 	client := &WSMarketClient{
 		conn:    conn,
 		Updates: &handler,
+		logger:  cfg.logger,
 		exit:    make(chan struct{}),
 	}
 
@@ -75,6 +75,12 @@ type wsHbdmMarketResponse struct {
 	Ts int    `json:"ts"`
 }
 
+// fail logs Websocket error and sends it to error feed
+func (c *WSMarketClient) fail(err error) {
+	c.logger.Error("hbdm websocket", "err", err)
+	c.Updates.ErrorFeed <- err
+}
+
 // handle message from websocket
 func (c *WSMarketClient) handle() {
 	for {
@@ -89,19 +95,19 @@ func (c *WSMarketClient) handle() {
 	HandleMessages:
 		_, message, err := c.conn.ReadMessage()
 		if err != nil {
-			c.Updates.ErrorFeed <- err
+			c.fail(err)
 			break
 		}
 
 		msg, err := gzipCompress(message)
 		if err != nil {
-			c.Updates.ErrorFeed <- err
+			c.fail(err)
 			break
 		}
 
 		ok, err := c.checkPing(msg)
 		if err != nil {
-			c.Updates.ErrorFeed <- err
+			c.fail(err)
 			break
 		}
 
@@ -111,7 +117,7 @@ func (c *WSMarketClient) handle() {
 
 		method, symbol, err := c.parseMethod(msg)
 		if err != nil {
-			c.Updates.ErrorFeed <- err
+			c.fail(err)
 			break
 		}
 
@@ -119,7 +125,7 @@ func (c *WSMarketClient) handle() {
 		case "depth":
 			var resp WsDepthMarketResponse
 			if err := json.Unmarshal(msg, &resp); err != nil {
-				c.Updates.ErrorFeed <- err
+				c.fail(err)
 				break
 			}
 			muM.Lock()
@@ -187,7 +193,6 @@ func (c WSMarketClient) parseMethod(msg []byte) (method, symbol string, err erro
 	var resp wsHbdmMarketResponse
 
 	if err := json.Unmarshal(msg, &resp); err != nil {
-		log.Printf("%s", err)
 		return "", "", err
 	}
 
@@ -213,34 +218,10 @@ type WsDepthMarketResponse struct {
 }
 
 // MarketDepthTick is Depth Offer main data
-type MarketDepthTick struct {
-	Ch      string  `json:"ch"`
-	Mrid    int     `json:"mrid"`
-	Id      int     `json:"id"`
-	Ts      int     `json:"ts"`
-	Version int     `json:"version"`
-	Bids    []Offer `json:"bids"`
-	Asks    []Offer `json:"asks"`
-}
+type MarketDepthTick = hbdm.MarketDepthTick
 
 // Offer is Offer with Contract Price and Amount
-type Offer struct {
-	Price  float64 `json:"price"`
-	Amount float64 `json:"amount"`
-}
-
-// UnmarshalJSON make correct Json Unmarshaling fro Offer structure
-func (o *Offer) UnmarshalJSON(b []byte) error {
-	var offer []float64
-
-	if err := json.Unmarshal(b, &offer); err != nil {
-		return fmt.Errorf("unmarshalling: %v", err)
-	}
-
-	o.Price = offer[0]
-	o.Amount = offer[1]
-	return nil
-}
+type Offer = hbdm.Offer
 
 // SubscribeMarketDepth subscribe to websocket Market Depth data
 func (c *WSMarketClient) SubscribeMarketDepth(symbol string) (<-chan WsDepthMarketResponse, error) {
@@ -259,14 +240,14 @@ func (c *WSMarketClient) SubscribeMarketDepth(symbol string) (<-chan WsDepthMark
 
 	msg, err := json.Marshal(request)
 	if err != nil {
-		log.Println(err)
+		c.logger.Error("hbdm websocket marshal request", "sub", sub, "err", err)
 	}
 
 	muM.Lock()
 	err = c.conn.WriteMessage(websocket.TextMessage, []byte(msg))
 	muM.Unlock()
 	if err != nil {
-		log.Println("write", err)
+		c.logger.Error("hbdm websocket write", "sub", sub, "err", err)
 	}
 
 	muM.Lock()
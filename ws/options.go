@@ -0,0 +1,37 @@
+package ws
+
+import (
+	"github.com/andskur/hbdm-go"
+)
+
+// Option configures Websocket client
+type Option func(c *config)
+
+// config is Websocket client configuration
+type config struct {
+	endpoints hbdm.Endpoints
+	logger    hbdm.Logger
+}
+
+// newConfig returns configuration with applied options
+func newConfig(opts []Option) *config {
+	c := &config{endpoints: hbdm.DefaultEndpoints, logger: hbdm.NewStdLogger(nil)}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// WithEndpoints sets Websocket API url's, use Hbdm.Endpoints to share REST client configuration
+func WithEndpoints(endpoints hbdm.Endpoints) Option {
+	return func(c *config) {
+		c.endpoints = endpoints
+	}
+}
+
+// WithLogger sets logger of Websocket errors, use Hbdm.Logger to share REST client logger
+func WithLogger(logger hbdm.Logger) Option {
+	return func(c *config) {
+		c.logger = logger
+	}
+}
@@ -4,13 +4,15 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"log"
+	"net/url"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
 
 	"github.com/gofrs/uuid"
 	"github.com/gorilla/websocket"
+	"github.com/shopspring/decimal"
 
 	"github.com/andskur/hbdm-go"
 )
@@ -20,11 +22,6 @@ var (
 	wgT sync.WaitGroup
 )
 
-// HBDM websocket API URL's
-const (
-	wsOrders = "wss://api.hbdm.com/notification"
-)
-
 // responseChannels handles all incoming data from the hbdm connection.
 type responseTradeChannels struct {
 	OrderPush map[string]chan WsOrderPushResponse
@@ -35,14 +32,24 @@ type responseTradeChannels struct {
 type WSTradeClient struct {
 	apiKey    string
 	apiSecret string
+	url       *url.URL
+	signHost  string
 	conn      *websocket.Conn
 	Updates   *responseTradeChannels
+	logger    hbdm.Logger
 	exit      chan struct{}
 }
 
 // NewWSTradeClient creates a new hbm Websocket API client
-func NewWSTradeClient(apiKey, apiSecret string) (*WSTradeClient, error) {
-	conn, _, err := websocket.DefaultDialer.Dial(wsOrders, nil)
+func NewWSTradeClient(apiKey, apiSecret string, opts ...Option) (*WSTradeClient, error) {
+	cfg := newConfig(opts)
+
+	u, err := url.Parse(cfg.endpoints.WSOrders)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, _, err := websocket.DefaultDialer.Dial(u.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -56,11 +63,18 @@ func NewWSTradeClient(apiKey, apiSecret string) (*WSTradeClient, error) {
 	client := &WSTradeClient{
 		apiKey:    apiKey,
 		apiSecret: apiSecret,
+		url:       u,
+		signHost:  u.Host,
 		conn:      conn,
 		Updates:   &handler,
+		logger:    cfg.logger,
 		exit:      make(chan struct{}),
 	}
 
+	if cfg.endpoints.SignHost != "" {
+		client.signHost = cfg.endpoints.SignHost
+	}
+
 	go client.handle()
 
 	if err := client.auth(); err != nil {
@@ -84,16 +98,14 @@ type TradeAuthRequest struct {
 // auth authenticate to Notification Websocket API
 func (c *WSTradeClient) auth() error {
 	timestamp := time.Now().UTC().Format("2006-01-02T15:04:05")
-	strRequest := "/notification"
 
 	mapParams2Sign := make(map[string]string)
 	mapParams2Sign["AccessKeyId"] = c.apiKey
 	mapParams2Sign["SignatureMethod"] = "HmacSHA256"
 	mapParams2Sign["SignatureVersion"] = "2"
 	mapParams2Sign["Timestamp"] = timestamp
-	hostName := "api.hbdm.com"
 
-	sign := hbdm.CreateSign(mapParams2Sign, "GET", hostName, strRequest, c.apiSecret)
+	sign := hbdm.CreateSign(mapParams2Sign, "GET", c.signHost, c.url.Path, c.apiSecret)
 
 	request := &TradeAuthRequest{
 		Op:               "auth",
@@ -107,19 +119,25 @@ func (c *WSTradeClient) auth() error {
 
 	msg, err := json.Marshal(request)
 	if err != nil {
-		log.Println(err)
+		c.logger.Error("hbdm websocket marshal auth request", "err", err)
 	}
 
 	muT.Lock()
 	err = c.conn.WriteMessage(websocket.TextMessage, []byte(msg))
 	muT.Unlock()
 	if err != nil {
-		log.Println("write", err)
+		c.logger.Error("hbdm websocket write auth request", "err", err)
 	}
 
 	return nil
 }
 
+// fail logs Websocket error and sends it to error feed
+func (c *WSTradeClient) fail(err error) {
+	c.logger.Error("hbdm websocket", "err", err)
+	c.Updates.ErrorFeed <- err
+}
+
 // handle message from websocket
 func (c *WSTradeClient) handle() {
 	for {
@@ -134,19 +152,19 @@ func (c *WSTradeClient) handle() {
 	HandleMessages:
 		_, message, err := c.conn.ReadMessage()
 		if err != nil {
-			c.Updates.ErrorFeed <- err
+			c.fail(err)
 			break
 		}
 
 		msg, err := gzipCompress(message)
 		if err != nil {
-			c.Updates.ErrorFeed <- err
+			c.fail(err)
 			break
 		}
 
 		ok, err := c.checkPing(msg)
 		if err != nil {
-			c.Updates.ErrorFeed <- err
+			c.fail(err)
 			break
 		}
 		if ok {
@@ -155,7 +173,7 @@ func (c *WSTradeClient) handle() {
 
 		method, symbol, err := c.parseMethod(msg)
 		if err != nil {
-			c.Updates.ErrorFeed <- err
+			c.fail(err)
 			break
 		}
 
@@ -163,7 +181,7 @@ func (c *WSTradeClient) handle() {
 		case "orders":
 			var resp WsOrderPushResponse
 			if err := json.Unmarshal(msg, &resp); err != nil {
-				c.Updates.ErrorFeed <- err
+				c.fail(err)
 				break
 			}
 			muT.Lock()
@@ -186,7 +204,6 @@ func (c *WSTradeClient) parseMethod(msg []byte) (method, symbol string, err erro
 	var resp wsHbdmTradeResponse
 
 	if err := json.Unmarshal(msg, &resp); err != nil {
-		log.Printf("%s", err)
 		return "", "", err
 	}
 
@@ -234,7 +251,7 @@ Pong:
 
 	pong := PingTrade{
 		Op: "pong",
-		Ts: string(time.Now().Unix()),
+		Ts: strconv.FormatInt(time.Now().Unix(), 10),
 	}
 
 	jsonPong, err := json.Marshal(pong)
@@ -261,41 +278,35 @@ type wsOrderPushRequest struct {
 
 // OrderPushResponse is response from Order Push method subscribe
 type WsOrderPushResponse struct {
-	Op             string       `json:"op"`
-	Topic          string       `json:"topic"`
-	Ts             int          `json:"ts"`
-	Symbol         string       `json:"symbol"`
-	ContractType   string       `json:"contract_type"`
-	ContractCode   string       `json:"contract_code"`
-	Volume         float64      `json:"volume"`
-	Price          float64      `json:"price"`
-	OrderPriceType string       `json:"order_price_type"`
-	Direction      string       `json:"direction"`
-	Offset         string       `json:"offset"`
-	Status         int          `json:"status"`
-	LevelRate      int          `json:"level_rate"`
-	OrderId        int          `json:"order_id"`
-	ClientOrderId  int          `json:"client_order_id"`
-	OrderSource    string       `json:"order_source"`
-	OrderType      int          `json:"order_type"`
-	CreatedAt      int          `json:"created_at"`
-	TradeVolume    float64      `json:"trade_volume"`
-	TradeTurnover  float64      `json:"trade_turnover"`
-	Fee            float64      `json:"fee"`
-	TradeAvgPrice  float64      `json:"trade_avg_price"`
-	MarginFrozen   float64      `json:"margin_frozen"`
-	Profit         float64      `json:"profit"`
-	Trade          []OrderTrade `json:"trade"`
+	Op             string          `json:"op"`
+	Topic          string          `json:"topic"`
+	Ts             int             `json:"ts"`
+	Symbol         string          `json:"symbol"`
+	ContractType   string          `json:"contract_type"`
+	ContractCode   string          `json:"contract_code"`
+	Volume         decimal.Decimal `json:"volume"`
+	Price          decimal.Decimal `json:"price"`
+	OrderPriceType string          `json:"order_price_type"`
+	Direction      string          `json:"direction"`
+	Offset         string          `json:"offset"`
+	Status         int             `json:"status"`
+	LevelRate      int             `json:"level_rate"`
+	OrderId        int64           `json:"order_id"`
+	ClientOrderId  int64           `json:"client_order_id"`
+	OrderSource    string          `json:"order_source"`
+	OrderType      int             `json:"order_type"`
+	CreatedAt      int             `json:"created_at"`
+	TradeVolume    decimal.Decimal `json:"trade_volume"`
+	TradeTurnover  decimal.Decimal `json:"trade_turnover"`
+	Fee            decimal.Decimal `json:"fee"`
+	TradeAvgPrice  decimal.Decimal `json:"trade_avg_price"`
+	MarginFrozen   decimal.Decimal `json:"margin_frozen"`
+	Profit         decimal.Decimal `json:"profit"`
+	Trade          []OrderTrade    `json:"trade"`
 }
 
-type OrderTrade struct {
-	TradeId       int     `json:"trade_id"`
-	TradeVolume   float64 `json:"trade_volume"`
-	TradePrice    float64 `json:"trade_price"`
-	TradeFee      float64 `json:"trade_fee"`
-	TradeTurnover float64 `json:"trade_turnover"`
-	CreatedAt     int     `json:"created_at"`
-}
+// OrderTrade is single fill of order
+type OrderTrade = hbdm.OrderTrade
 
 // SubscribeOrderPush subscribe to websocket Order Push data
 func (c *WSTradeClient) SubscribeOrderPush(symbol string) (<-chan WsOrderPushResponse, error) {
@@ -318,14 +329,14 @@ func (c *WSTradeClient) SubscribeOrderPush(symbol string) (<-chan WsOrderPushRes
 
 	msg, err := json.Marshal(request)
 	if err != nil {
-		log.Println(err)
+		c.logger.Error("hbdm websocket marshal request", "topic", topik, "err", err)
 	}
 
 	muT.Lock()
 	err = c.conn.WriteMessage(websocket.TextMessage, []byte(msg))
 	muT.Unlock()
 	if err != nil {
-		log.Println("write", err)
+		c.logger.Error("hbdm websocket write", "topic", topik, "err", err)
 	}
 
 	muT.Lock()